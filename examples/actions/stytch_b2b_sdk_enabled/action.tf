@@ -0,0 +1,10 @@
+# Disable the B2B SDK for an environment during an incident without editing the
+# stytch_b2b_sdk_config resource. Run with:
+#   terraform apply -invoke=action.stytch_b2b_sdk_enabled.kill_switch
+action "stytch_b2b_sdk_enabled" "kill_switch" {
+  config {
+    project_slug     = "my-project"
+    environment_slug = "production"
+    enabled          = false
+  }
+}
@@ -0,0 +1,10 @@
+# Reset a broken test environment to the Stytch defaults without removing the
+# resource from state, then re-apply the intended config. Run with:
+#   terraform apply -invoke=action.stytch_jwt_template_reset.reset
+action "stytch_jwt_template_reset" "reset" {
+  config {
+    project_slug     = "my-project"
+    environment_slug = "test"
+    template_type    = "SESSION"
+  }
+}
@@ -0,0 +1,6 @@
+# Read an existing secret owned by another workspace without taking ownership of it
+ephemeral "stytch_secret_value" "secret" {
+  project_slug     = "my-project"
+  environment_slug = "test"
+  secret_id        = "secret-test-00000000-0000-0000-0000-000000000000"
+}
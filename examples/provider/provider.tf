@@ -17,3 +17,35 @@ provider "stytch" {
 # Use the STYTCH_WORKSPACE_KEY_ID and STYTCH_WORKSPACE_KEY_SECRET environment variables
 # This is the recommended way to configure the provider.
 provider "stytch" {}
+
+# Profile-based authentication
+# Reads the workspace key from a profile in ~/.config/stytch/credentials:
+#
+#   [prod-workspace]
+#   workspace_key_id     = workspace-key-prod-00000000-0000-0000-0000-000000000000
+#   workspace_key_secret = ***
+#
+# A profile can instead set credential_process to a command that prints the workspace key as JSON.
+provider "stytch" {
+  profile = "prod-workspace"
+}
+
+# Command-based authentication
+# The command must print {"workspace_key_id": "...", "workspace_key_secret": "..."}
+provider "stytch" {
+  credential_process = "op read op://Infrastructure/stytch-workspace-key/credentials.json"
+}
+
+# Provider-level defaults
+# Resources, ephemeral resources and actions that omit project_slug or environment_slug use these.
+provider "stytch" {
+  default_project_slug     = "my-project"
+  default_environment_slug = "production"
+}
+
+# Protected environments
+# Plans that destroy or replace resources in a live environment or in the staging environment of
+# my-project fail unless allow_protected_changes is set.
+provider "stytch" {
+  protected_environments = ["LIVE", "my-project.staging"]
+}
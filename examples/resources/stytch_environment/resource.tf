@@ -37,3 +37,11 @@ resource "stytch_environment" "test_with_lock" {
   user_lock_ttl                = 1800 # 30 minutes in seconds
 }
 
+# Manage the project's existing live environment, which is updated but never
+# created or deleted by this resource
+resource "stytch_environment" "live" {
+  project_slug = stytch_project.example.project_slug
+  type         = "LIVE"
+  name         = "Production"
+}
+
@@ -79,3 +79,10 @@ resource "stytch_project" "full_config" {
     idp_dynamic_client_registration_enabled = true
   }
 }
+
+# Create a project that can be destroyed without first disabling deletion protection
+resource "stytch_project" "sandbox" {
+  name                = "Sandbox"
+  vertical            = "CONSUMER"
+  deletion_protection = false
+}
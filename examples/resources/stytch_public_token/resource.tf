@@ -3,3 +3,12 @@ resource "stytch_public_token" "example" {
   project_slug     = "my-project"
   environment_slug = "production"
 }
+
+# Rotate the public token on every release, keeping the token from the previous
+# release active for two more applies while clients are updated
+resource "stytch_public_token" "rotated" {
+  project_slug     = "my-project"
+  environment_slug = "production"
+  rotation_trigger = "2024-06-release"
+  retain_previous  = 2
+}
@@ -1,3 +1,7 @@
 # A Stytch redirect URL can be imported by specifying the project slug, environment slug, and URL
 # Format: project_slug.environment_slug.url
 terraform import stytch_redirect_url.example my-project.production.https://myapp.example.com/auth/callback
+
+# The parts can also be separated by "|", or given as a JSON array, such as for URLs that contain "|"
+terraform import stytch_redirect_url.example 'my-project|production|https://myapp.example.com/auth/callback'
+terraform import stytch_redirect_url.example '["my-project","production","https://myapp.example.com/auth/callback?a=1|2"]'
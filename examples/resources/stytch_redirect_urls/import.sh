@@ -0,0 +1,3 @@
+# The redirect URLs of an environment can be imported by specifying the project slug and environment slug
+# Format: project_slug.environment_slug
+terraform import stytch_redirect_urls.example my-project.production
@@ -0,0 +1,33 @@
+# Example: All redirect URLs of an environment. Any other redirect URL, such as one added in the
+# dashboard, is deleted.
+resource "stytch_redirect_urls" "production" {
+  project_slug     = "my-project"
+  environment_slug = "production"
+
+  redirect_urls = {
+    "https://myapp.example.com/auth/callback" = {
+      valid_types = [
+        {
+          type       = "LOGIN"
+          is_default = true
+        },
+        {
+          type       = "SIGNUP"
+          is_default = true
+        }
+      ]
+    }
+    "https://myapp.example.com/reset-password" = {
+      valid_types = [
+        {
+          type       = "RESET_PASSWORD"
+          is_default = true
+        },
+        {
+          type       = "LOGIN"
+          is_default = false
+        }
+      ]
+    }
+  }
+}
@@ -0,0 +1,166 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/sdk"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/providerdata"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action               = &b2bSDKEnabledAction{}
+	_ action.ActionWithConfigure  = &b2bSDKEnabledAction{}
+	_ action.ActionWithModifyPlan = &b2bSDKEnabledAction{}
+)
+
+func NewB2BSDKEnabledAction() action.Action {
+	return &b2bSDKEnabledAction{}
+}
+
+type b2bSDKEnabledAction struct {
+	client   *api.API
+	defaults providerdata.Defaults
+	readOnly bool
+}
+
+type sdkEnabledModel struct {
+	ProjectSlug     types.String `tfsdk:"project_slug"`
+	EnvironmentSlug types.String `tfsdk:"environment_slug"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+}
+
+// sdkEnabledSchema returns the schema shared by the B2B and consumer SDK enabled actions.
+func sdkEnabledSchema(vertical string) schema.Schema {
+	return schema.Schema{
+		Description: fmt.Sprintf("Enables or disables the %s SDK for an environment without changing any other "+
+			"part of its SDK config. This is intended to be used as a kill switch during an incident, so "+
+			"the rest of the SDK config managed in Terraform does not need to be edited.", vertical),
+		Attributes: map[string]schema.Attribute{
+			"project_slug": schema.StringAttribute{
+				Optional:    true,
+				Description: "The slug of the project for which to toggle the SDK. Defaults to the provider's `default_project_slug`.",
+			},
+			"environment_slug": schema.StringAttribute{
+				Optional:    true,
+				Description: "The slug of the environment for which to toggle the SDK. Defaults to the provider's `default_environment_slug`.",
+			},
+			"enabled": schema.BoolAttribute{
+				Required:    true,
+				Description: "Whether the SDK should be enabled.",
+			},
+		},
+	}
+}
+
+func (a *b2bSDKEnabledAction) Configure(
+	_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse,
+) {
+	// Add a nil check when handling ProviderData because Terraform sets that data after it calls the
+	// ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerdata.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report "+
+				"this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = data.Client
+	a.defaults = data.Defaults
+	a.readOnly = data.ReadOnly
+}
+
+// Metadata returns the action type name.
+func (a *b2bSDKEnabledAction) Metadata(
+	_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_b2b_sdk_enabled"
+}
+
+// Schema defines the schema for the action.
+func (a *b2bSDKEnabledAction) Schema(
+	_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse,
+) {
+	resp.Schema = sdkEnabledSchema("B2B")
+}
+
+// ModifyPlan reports a project or environment slug that is neither configured nor defaulted by the
+// provider at plan time rather than when the action is invoked.
+func (a *b2bSDKEnabledAction) ModifyPlan(
+	ctx context.Context, req action.ModifyPlanRequest, resp *action.ModifyPlanResponse,
+) {
+	checkDefaultSlugs(ctx, req.Config, a.defaults, &resp.Diagnostics)
+}
+
+// Invoke toggles whether the B2B SDK is enabled.
+func (a *b2bSDKEnabledAction) Invoke(
+	ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse,
+) {
+	if !providerdata.CheckWritable(a.readOnly, &resp.Diagnostics, "invoke this action") {
+		return
+	}
+
+	var config sdkEnabledModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	config.ProjectSlug = a.defaults.ApplyProjectSlug(&resp.Diagnostics, config.ProjectSlug)
+	config.EnvironmentSlug = a.defaults.ApplyEnvironmentSlug(&resp.Diagnostics, config.EnvironmentSlug)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "project_slug", config.ProjectSlug.ValueString())
+	ctx = tflog.SetField(ctx, "environment_slug", config.EnvironmentSlug.ValueString())
+	ctx = tflog.SetField(ctx, "enabled", config.Enabled.ValueBool())
+	tflog.Info(ctx, "Setting B2B SDK enabled")
+
+	getResp, err := a.client.SDK.GetB2BConfig(ctx, sdk.GetB2BConfigRequest{
+		ProjectSlug:     config.ProjectSlug.ValueString(),
+		EnvironmentSlug: config.EnvironmentSlug.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get B2B SDK config", err.Error())
+		return
+	}
+
+	// Only the basic config is sent so that every other part of the SDK config is left as-is. The
+	// rest of the basic config is carried over so that authorized domains and bundle IDs are kept.
+	basic := sdk.B2BBasicConfig{}
+	if getResp.Config.Basic != nil {
+		basic = *getResp.Config.Basic
+	}
+	basic.Enabled = config.Enabled.ValueBool()
+
+	_, err = a.client.SDK.SetB2BConfig(ctx, sdk.SetB2BConfigRequest{
+		ProjectSlug:     config.ProjectSlug.ValueString(),
+		EnvironmentSlug: config.EnvironmentSlug.ValueString(),
+		Config: &sdk.B2BConfig{
+			Basic: &basic,
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to set B2B SDK config", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Set B2B SDK enabled")
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("B2B SDK enabled set to %t for %s.%s", basic.Enabled,
+			config.ProjectSlug.ValueString(), config.EnvironmentSlug.ValueString()),
+	})
+}
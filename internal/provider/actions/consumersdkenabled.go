@@ -0,0 +1,135 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/sdk"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/providerdata"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action               = &consumerSDKEnabledAction{}
+	_ action.ActionWithConfigure  = &consumerSDKEnabledAction{}
+	_ action.ActionWithModifyPlan = &consumerSDKEnabledAction{}
+)
+
+func NewConsumerSDKEnabledAction() action.Action {
+	return &consumerSDKEnabledAction{}
+}
+
+type consumerSDKEnabledAction struct {
+	client   *api.API
+	defaults providerdata.Defaults
+	readOnly bool
+}
+
+func (a *consumerSDKEnabledAction) Configure(
+	_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse,
+) {
+	// Add a nil check when handling ProviderData because Terraform sets that data after it calls the
+	// ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerdata.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report "+
+				"this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = data.Client
+	a.defaults = data.Defaults
+	a.readOnly = data.ReadOnly
+}
+
+// Metadata returns the action type name.
+func (a *consumerSDKEnabledAction) Metadata(
+	_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_consumer_sdk_enabled"
+}
+
+// Schema defines the schema for the action.
+func (a *consumerSDKEnabledAction) Schema(
+	_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse,
+) {
+	resp.Schema = sdkEnabledSchema("consumer")
+}
+
+// ModifyPlan reports a project or environment slug that is neither configured nor defaulted by the
+// provider at plan time rather than when the action is invoked.
+func (a *consumerSDKEnabledAction) ModifyPlan(
+	ctx context.Context, req action.ModifyPlanRequest, resp *action.ModifyPlanResponse,
+) {
+	checkDefaultSlugs(ctx, req.Config, a.defaults, &resp.Diagnostics)
+}
+
+// Invoke toggles whether the Consumer SDK is enabled.
+func (a *consumerSDKEnabledAction) Invoke(
+	ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse,
+) {
+	if !providerdata.CheckWritable(a.readOnly, &resp.Diagnostics, "invoke this action") {
+		return
+	}
+
+	var config sdkEnabledModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	config.ProjectSlug = a.defaults.ApplyProjectSlug(&resp.Diagnostics, config.ProjectSlug)
+	config.EnvironmentSlug = a.defaults.ApplyEnvironmentSlug(&resp.Diagnostics, config.EnvironmentSlug)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "project_slug", config.ProjectSlug.ValueString())
+	ctx = tflog.SetField(ctx, "environment_slug", config.EnvironmentSlug.ValueString())
+	ctx = tflog.SetField(ctx, "enabled", config.Enabled.ValueBool())
+	tflog.Info(ctx, "Setting Consumer SDK enabled")
+
+	getResp, err := a.client.SDK.GetConsumerConfig(ctx, sdk.GetConsumerConfigRequest{
+		ProjectSlug:     config.ProjectSlug.ValueString(),
+		EnvironmentSlug: config.EnvironmentSlug.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get Consumer SDK config", err.Error())
+		return
+	}
+
+	// Only the basic config is sent so that every other part of the SDK config is left as-is. The
+	// rest of the basic config is carried over so that authorized domains and bundle IDs are kept.
+	basic := sdk.ConsumerBasicConfig{}
+	if getResp.Config.Basic != nil {
+		basic = *getResp.Config.Basic
+	}
+	basic.Enabled = config.Enabled.ValueBool()
+
+	_, err = a.client.SDK.SetConsumerConfig(ctx, sdk.SetConsumerConfigRequest{
+		ProjectSlug:     config.ProjectSlug.ValueString(),
+		EnvironmentSlug: config.EnvironmentSlug.ValueString(),
+		Config: &sdk.ConsumerConfig{
+			Basic: &basic,
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to set Consumer SDK config", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Set Consumer SDK enabled")
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Consumer SDK enabled set to %t for %s.%s", basic.Enabled,
+			config.ProjectSlug.ValueString(), config.EnvironmentSlug.ValueString()),
+	})
+}
@@ -0,0 +1,197 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/countrycodeallowlist"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/providerdata"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/resources"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action               = &countryCodeAllowlistResetAction{}
+	_ action.ActionWithConfigure  = &countryCodeAllowlistResetAction{}
+	_ action.ActionWithModifyPlan = &countryCodeAllowlistResetAction{}
+)
+
+func NewCountryCodeAllowlistResetAction() action.Action {
+	return &countryCodeAllowlistResetAction{}
+}
+
+type countryCodeAllowlistResetAction struct {
+	client   *api.API
+	defaults providerdata.Defaults
+	readOnly bool
+}
+
+type countryCodeAllowlistResetModel struct {
+	ProjectSlug     types.String `tfsdk:"project_slug"`
+	EnvironmentSlug types.String `tfsdk:"environment_slug"`
+	DeliveryMethod  types.String `tfsdk:"delivery_method"`
+}
+
+func (a *countryCodeAllowlistResetAction) Configure(
+	_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse,
+) {
+	// Add a nil check when handling ProviderData because Terraform sets that data after it calls the
+	// ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerdata.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report "+
+				"this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = data.Client
+	a.defaults = data.Defaults
+	a.readOnly = data.ReadOnly
+}
+
+// Metadata returns the action type name.
+func (a *countryCodeAllowlistResetAction) Metadata(
+	_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_country_code_allowlist_reset"
+}
+
+// Schema defines the schema for the action.
+func (a *countryCodeAllowlistResetAction) Schema(
+	_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Resets the country code allowlist for a delivery method to the default allowed country " +
+			"codes, the same way destroying a stytch_country_code_allowlist resource does, but without " +
+			"removing it from state. The values before and after the reset are reported as progress messages.",
+		Attributes: map[string]schema.Attribute{
+			"project_slug": schema.StringAttribute{
+				Optional:    true,
+				Description: "The slug of the project for which to reset the country code allowlist. Defaults to the provider's `default_project_slug`.",
+			},
+			"environment_slug": schema.StringAttribute{
+				Optional:    true,
+				Description: "The slug of the environment for which to reset the country code allowlist. Defaults to the provider's `default_environment_slug`.",
+			},
+			"delivery_method": schema.StringAttribute{
+				Required:    true,
+				Description: "The delivery method for the country code allowlist. Valid values: sms, whatsapp.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(resources.DeliveryMethodSMS),
+						string(resources.DeliveryMethodWhatsApp),
+					),
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan reports a project or environment slug that is neither configured nor defaulted by the
+// provider at plan time rather than when the action is invoked.
+func (a *countryCodeAllowlistResetAction) ModifyPlan(
+	ctx context.Context, req action.ModifyPlanRequest, resp *action.ModifyPlanResponse,
+) {
+	checkDefaultSlugs(ctx, req.Config, a.defaults, &resp.Diagnostics)
+}
+
+// Invoke resets the country code allowlist to the default allowed country codes.
+func (a *countryCodeAllowlistResetAction) Invoke(
+	ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse,
+) {
+	if !providerdata.CheckWritable(a.readOnly, &resp.Diagnostics, "invoke this action") {
+		return
+	}
+
+	var config countryCodeAllowlistResetModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	config.ProjectSlug = a.defaults.ApplyProjectSlug(&resp.Diagnostics, config.ProjectSlug)
+	config.EnvironmentSlug = a.defaults.ApplyEnvironmentSlug(&resp.Diagnostics, config.EnvironmentSlug)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "project_slug", config.ProjectSlug.ValueString())
+	ctx = tflog.SetField(ctx, "environment_slug", config.EnvironmentSlug.ValueString())
+	ctx = tflog.SetField(ctx, "delivery_method", config.DeliveryMethod.ValueString())
+	tflog.Info(ctx, "Resetting country code allowlist to default value")
+
+	unlock := utils.WriteLocks.Lock(utils.EnvironmentLockKey(utils.LockFamilyCountryCodeAllowlist, config.ProjectSlug.ValueString(), config.EnvironmentSlug.ValueString()))
+	defer unlock()
+
+	var before, after []string
+	if config.DeliveryMethod.ValueString() == string(resources.DeliveryMethodSMS) {
+		getResp, err := a.client.CountryCodeAllowlist.GetAllowedSMSCountryCodes(ctx,
+			countrycodeallowlist.GetAllowedSMSCountryCodesRequest{
+				ProjectSlug:     config.ProjectSlug.ValueString(),
+				EnvironmentSlug: config.EnvironmentSlug.ValueString(),
+			})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to get country code allowlist", err.Error())
+			return
+		}
+		before = getResp.CountryCodes
+
+		setResp, err := a.client.CountryCodeAllowlist.SetAllowedSMSCountryCodes(ctx,
+			countrycodeallowlist.SetAllowedSMSCountryCodesRequest{
+				ProjectSlug:     config.ProjectSlug.ValueString(),
+				EnvironmentSlug: config.EnvironmentSlug.ValueString(),
+				CountryCodes:    resources.DefaultCountryCodes,
+			})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to reset country code allowlist", err.Error())
+			return
+		}
+		after = setResp.CountryCodes
+	} else {
+		getResp, err := a.client.CountryCodeAllowlist.GetAllowedWhatsAppCountryCodes(ctx,
+			countrycodeallowlist.GetAllowedWhatsAppCountryCodesRequest{
+				ProjectSlug:     config.ProjectSlug.ValueString(),
+				EnvironmentSlug: config.EnvironmentSlug.ValueString(),
+			})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to get country code allowlist", err.Error())
+			return
+		}
+		before = getResp.CountryCodes
+
+		setResp, err := a.client.CountryCodeAllowlist.SetAllowedWhatsAppCountryCodes(ctx,
+			countrycodeallowlist.SetAllowedWhatsAppCountryCodesRequest{
+				ProjectSlug:     config.ProjectSlug.ValueString(),
+				EnvironmentSlug: config.EnvironmentSlug.ValueString(),
+				CountryCodes:    resources.DefaultCountryCodes,
+			})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to reset country code allowlist", err.Error())
+			return
+		}
+		after = setResp.CountryCodes
+	}
+
+	tflog.Info(ctx, "Reset country code allowlist to default state")
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Country code allowlist before reset: [%s]", strings.Join(before, ", ")),
+	})
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Country code allowlist after reset: [%s]", strings.Join(after, ", ")),
+	})
+}
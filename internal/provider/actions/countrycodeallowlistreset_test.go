@@ -0,0 +1,64 @@
+package actions_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/testutil"
+)
+
+func TestAccCountryCodeAllowlistResetAction(t *testing.T) {
+	config := testutil.ProviderConfig + testutil.ConsumerProjectConfig + testutil.EnvironmentResource(testutil.EnvironmentResourceArgs{
+		ProjectSlug: "stytch_project.test.project_slug",
+		Name:        "Test Environment",
+	}) + `
+      resource "stytch_country_code_allowlist" "test" {
+        project_slug     = stytch_project.test.project_slug
+        environment_slug = stytch_environment.test.environment_slug
+        delivery_method  = "sms"
+        country_codes    = ["CA", "GB", "US"]
+      }
+
+      action "stytch_country_code_allowlist_reset" "test" {
+        config {
+          project_slug     = stytch_country_code_allowlist.test.project_slug
+          environment_slug = stytch_country_code_allowlist.test.environment_slug
+          delivery_method  = stytch_country_code_allowlist.test.delivery_method
+        }
+      }
+
+      resource "terraform_data" "trigger" {
+        input = stytch_country_code_allowlist.test.id
+
+        lifecycle {
+          action_trigger {
+            events  = [after_create]
+            actions = [action.stytch_country_code_allowlist_reset.test]
+          }
+        }
+      }`
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// Actions are only available in Terraform 1.14 and later.
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The action resets the config outside of the managed resource, so a diff is expected.
+				Config:             config,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// The resource is still in state and now reflects the defaults.
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("stytch_country_code_allowlist.test", "country_codes.#", "2"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,27 @@
+package actions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/providerdata"
+)
+
+// checkDefaultSlugs reports at plan time when the project or environment slug is omitted from the
+// action configuration and the provider has no default for it.
+func checkDefaultSlugs(
+	ctx context.Context, config tfsdk.Config, defaults providerdata.Defaults, diags *diag.Diagnostics,
+) {
+	var projectSlug, environmentSlug types.String
+	diags.Append(config.GetAttribute(ctx, path.Root("project_slug"), &projectSlug)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("environment_slug"), &environmentSlug)...)
+	if diags.HasError() {
+		return
+	}
+
+	defaults.ApplyProjectSlug(diags, projectSlug)
+	defaults.ApplyEnvironmentSlug(diags, environmentSlug)
+}
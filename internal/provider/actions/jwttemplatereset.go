@@ -0,0 +1,169 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/jwttemplates"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/providerdata"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/resources"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action               = &jwtTemplateResetAction{}
+	_ action.ActionWithConfigure  = &jwtTemplateResetAction{}
+	_ action.ActionWithModifyPlan = &jwtTemplateResetAction{}
+)
+
+func NewJWTTemplateResetAction() action.Action {
+	return &jwtTemplateResetAction{}
+}
+
+type jwtTemplateResetAction struct {
+	client   *api.API
+	defaults providerdata.Defaults
+	readOnly bool
+}
+
+type jwtTemplateResetModel struct {
+	ProjectSlug     types.String `tfsdk:"project_slug"`
+	EnvironmentSlug types.String `tfsdk:"environment_slug"`
+	TemplateType    types.String `tfsdk:"template_type"`
+}
+
+func (a *jwtTemplateResetAction) Configure(
+	_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse,
+) {
+	// Add a nil check when handling ProviderData because Terraform sets that data after it calls the
+	// ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerdata.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report "+
+				"this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = data.Client
+	a.defaults = data.Defaults
+	a.readOnly = data.ReadOnly
+}
+
+// Metadata returns the action type name.
+func (a *jwtTemplateResetAction) Metadata(
+	_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_jwt_template_reset"
+}
+
+// Schema defines the schema for the action.
+func (a *jwtTemplateResetAction) Schema(
+	_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Resets a JWT template for an environment to its empty default value, the same way " +
+			"destroying a stytch_jwt_template resource does, but without removing it from state. The " +
+			"values before and after the reset are reported as progress messages.",
+		Attributes: map[string]schema.Attribute{
+			"project_slug": schema.StringAttribute{
+				Optional:    true,
+				Description: "The slug of the project for which to reset the JWT template. Defaults to the provider's `default_project_slug`.",
+			},
+			"environment_slug": schema.StringAttribute{
+				Optional:    true,
+				Description: "The slug of the environment for which to reset the JWT template. Defaults to the provider's `default_environment_slug`.",
+			},
+			"template_type": schema.StringAttribute{
+				Required:    true,
+				Description: "The type of JWT template to reset. Valid values: SESSION, M2M.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(jwttemplates.JWTTemplateTypeSession),
+						string(jwttemplates.JWTTemplateTypeM2M),
+					),
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan reports a project or environment slug that is neither configured nor defaulted by the
+// provider at plan time rather than when the action is invoked.
+func (a *jwtTemplateResetAction) ModifyPlan(
+	ctx context.Context, req action.ModifyPlanRequest, resp *action.ModifyPlanResponse,
+) {
+	checkDefaultSlugs(ctx, req.Config, a.defaults, &resp.Diagnostics)
+}
+
+// Invoke resets the JWT template to its default value.
+func (a *jwtTemplateResetAction) Invoke(
+	ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse,
+) {
+	if !providerdata.CheckWritable(a.readOnly, &resp.Diagnostics, "invoke this action") {
+		return
+	}
+
+	var config jwtTemplateResetModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	config.ProjectSlug = a.defaults.ApplyProjectSlug(&resp.Diagnostics, config.ProjectSlug)
+	config.EnvironmentSlug = a.defaults.ApplyEnvironmentSlug(&resp.Diagnostics, config.EnvironmentSlug)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "project_slug", config.ProjectSlug.ValueString())
+	ctx = tflog.SetField(ctx, "environment_slug", config.EnvironmentSlug.ValueString())
+	ctx = tflog.SetField(ctx, "template_type", config.TemplateType.ValueString())
+	tflog.Info(ctx, "Resetting JWT template to default values")
+
+	templateType := jwttemplates.JWTTemplateType(config.TemplateType.ValueString())
+	getResp, err := a.client.JWTTemplates.Get(ctx, jwttemplates.GetRequest{
+		ProjectSlug:     config.ProjectSlug.ValueString(),
+		EnvironmentSlug: config.EnvironmentSlug.ValueString(),
+		JWTTemplateType: templateType,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get JWT template", err.Error())
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "JWT template before reset: " + formatJWTTemplate(getResp.JWTTemplate),
+	})
+
+	setResp, err := a.client.JWTTemplates.Set(ctx, resources.DefaultJWTTemplate(
+		config.ProjectSlug.ValueString(),
+		config.EnvironmentSlug.ValueString(),
+		templateType,
+	))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to reset JWT template to default values", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "JWT template reset to default values")
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "JWT template after reset: " + formatJWTTemplate(setResp.JWTTemplate),
+	})
+}
+
+func formatJWTTemplate(t jwttemplates.JWTTemplate) string {
+	return fmt.Sprintf("template_content=%q custom_audience=%q", t.TemplateContent, t.CustomAudience)
+}
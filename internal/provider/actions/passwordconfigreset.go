@@ -0,0 +1,159 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/passwordstrengthconfig"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/providerdata"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/resources"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action               = &passwordConfigResetAction{}
+	_ action.ActionWithConfigure  = &passwordConfigResetAction{}
+	_ action.ActionWithModifyPlan = &passwordConfigResetAction{}
+)
+
+func NewPasswordConfigResetAction() action.Action {
+	return &passwordConfigResetAction{}
+}
+
+type passwordConfigResetAction struct {
+	client   *api.API
+	defaults providerdata.Defaults
+	readOnly bool
+}
+
+type passwordConfigResetModel struct {
+	ProjectSlug     types.String `tfsdk:"project_slug"`
+	EnvironmentSlug types.String `tfsdk:"environment_slug"`
+}
+
+func (a *passwordConfigResetAction) Configure(
+	_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse,
+) {
+	// Add a nil check when handling ProviderData because Terraform sets that data after it calls the
+	// ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerdata.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report "+
+				"this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = data.Client
+	a.defaults = data.Defaults
+	a.readOnly = data.ReadOnly
+}
+
+// Metadata returns the action type name.
+func (a *passwordConfigResetAction) Metadata(
+	_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_password_config_reset"
+}
+
+// Schema defines the schema for the action.
+func (a *passwordConfigResetAction) Schema(
+	_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Resets the password config for an environment to the Stytch defaults, the same way " +
+			"destroying a stytch_password_config resource does, but without removing it from state. The " +
+			"values before and after the reset are reported as progress messages.",
+		Attributes: map[string]schema.Attribute{
+			"project_slug": schema.StringAttribute{
+				Optional:    true,
+				Description: "The slug of the project for which to reset the password config. Defaults to the provider's `default_project_slug`.",
+			},
+			"environment_slug": schema.StringAttribute{
+				Optional:    true,
+				Description: "The slug of the environment for which to reset the password config. Defaults to the provider's `default_environment_slug`.",
+			},
+		},
+	}
+}
+
+// ModifyPlan reports a project or environment slug that is neither configured nor defaulted by the
+// provider at plan time rather than when the action is invoked.
+func (a *passwordConfigResetAction) ModifyPlan(
+	ctx context.Context, req action.ModifyPlanRequest, resp *action.ModifyPlanResponse,
+) {
+	checkDefaultSlugs(ctx, req.Config, a.defaults, &resp.Diagnostics)
+}
+
+// Invoke resets the password config to its defaults.
+func (a *passwordConfigResetAction) Invoke(
+	ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse,
+) {
+	if !providerdata.CheckWritable(a.readOnly, &resp.Diagnostics, "invoke this action") {
+		return
+	}
+
+	var config passwordConfigResetModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	config.ProjectSlug = a.defaults.ApplyProjectSlug(&resp.Diagnostics, config.ProjectSlug)
+	config.EnvironmentSlug = a.defaults.ApplyEnvironmentSlug(&resp.Diagnostics, config.EnvironmentSlug)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "project_slug", config.ProjectSlug.ValueString())
+	ctx = tflog.SetField(ctx, "environment_slug", config.EnvironmentSlug.ValueString())
+	tflog.Info(ctx, "Resetting password config to defaults")
+
+	getResp, err := a.client.PasswordStrengthConfig.Get(ctx, passwordstrengthconfig.GetRequest{
+		ProjectSlug:     config.ProjectSlug.ValueString(),
+		EnvironmentSlug: config.EnvironmentSlug.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get password config", err.Error())
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "Password config before reset: " + formatPasswordConfig(getResp.PasswordStrengthConfig),
+	})
+
+	setResp, err := a.client.PasswordStrengthConfig.Set(ctx,
+		resources.DefaultPasswordConfig(config.ProjectSlug.ValueString(), config.EnvironmentSlug.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to reset password config", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Reset password config to defaults")
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "Password config after reset: " + formatPasswordConfig(setResp.PasswordStrengthConfig),
+	})
+}
+
+func formatPasswordConfig(c passwordstrengthconfig.PasswordStrengthConfig) string {
+	s := fmt.Sprintf("validation_policy=%s check_breach_on_creation=%t check_breach_on_authentication=%t "+
+		"validate_on_authentication=%t", c.ValidationPolicy, c.CheckBreachOnCreation,
+		c.CheckBreachOnAuthentication, c.ValidateOnAuthentication)
+	if c.LudsMinPasswordLength != nil {
+		s += fmt.Sprintf(" luds_min_password_length=%d", *c.LudsMinPasswordLength)
+	}
+	if c.LudsMinPasswordComplexity != nil {
+		s += fmt.Sprintf(" luds_min_password_complexity=%d", *c.LudsMinPasswordComplexity)
+	}
+	return s
+}
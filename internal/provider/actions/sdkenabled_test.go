@@ -0,0 +1,92 @@
+package actions_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/testutil"
+)
+
+func TestAccSDKEnabledActions(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		projectConfig string
+		sdkConfig     string
+		action        string
+	}{
+		{
+			name:          "b2b",
+			projectConfig: testutil.B2BProjectConfig,
+			sdkConfig:     "stytch_b2b_sdk_config",
+			action:        "stytch_b2b_sdk_enabled",
+		},
+		{
+			name:          "consumer",
+			projectConfig: testutil.ConsumerProjectConfig,
+			sdkConfig:     "stytch_consumer_sdk_config",
+			action:        "stytch_consumer_sdk_enabled",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			config := testutil.ProviderConfig + tc.projectConfig + testutil.EnvironmentResource(testutil.EnvironmentResourceArgs{
+				ProjectSlug: "stytch_project.test.project_slug",
+				Name:        "Test Environment",
+			}) + fmt.Sprintf(`
+      resource "%[1]s" "test" {
+        project_slug     = stytch_project.test.project_slug
+        environment_slug = stytch_environment.test.environment_slug
+        config = {
+          basic = {
+            enabled    = true
+            bundle_ids = ["com.stytch.app"]
+          }
+        }
+      }
+
+      action "%[2]s" "test" {
+        config {
+          project_slug     = %[1]s.test.project_slug
+          environment_slug = %[1]s.test.environment_slug
+          enabled          = false
+        }
+      }
+
+      resource "terraform_data" "trigger" {
+        input = %[1]s.test.id
+
+        lifecycle {
+          action_trigger {
+            events  = [after_create]
+            actions = [action.%[2]s.test]
+          }
+        }
+      }`, tc.sdkConfig, tc.action)
+
+			resource.Test(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					// Actions are only available in Terraform 1.14 and later.
+					tfversion.SkipBelow(tfversion.Version1_14_0),
+				},
+				ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						// The action disables the SDK outside of the managed config, so a diff is expected.
+						Config:             config,
+						ExpectNonEmptyPlan: true,
+					},
+					{
+						// Only the enabled flag should have changed.
+						RefreshState: true,
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr(tc.sdkConfig+".test", "config.basic.enabled", "false"),
+							resource.TestCheckResourceAttr(tc.sdkConfig+".test", "config.basic.bundle_ids.#", "1"),
+						),
+						ExpectNonEmptyPlan: true,
+					},
+				},
+			})
+		})
+	}
+}
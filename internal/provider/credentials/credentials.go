@@ -0,0 +1,189 @@
+package credentials
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+const DefaultProfile = "default"
+
+// ErrProfileNotFound is returned when the credentials file doesn't contain the requested profile.
+var ErrProfileNotFound = errors.New("profile not found")
+
+// Credentials is a workspace management key pair.
+type Credentials struct {
+	WorkspaceKeyID     string `json:"workspace_key_id"`
+	WorkspaceKeySecret string `json:"workspace_key_secret"`
+}
+
+// Profile is a named section of a credentials file. A profile either sets the workspace key pair
+// directly or sets a credential_process command that prints it.
+//
+//	[prod-workspace]
+//	workspace_key_id     = workspace-key-prod-00000000-0000-0000-0000-000000000000
+//	workspace_key_secret = ***
+//
+//	[vault]
+//	credential_process = vault kv get -format=json -field=data secret/stytch
+type Profile struct {
+	WorkspaceKeyID     string
+	WorkspaceKeySecret string
+	CredentialProcess  string
+}
+
+// DefaultFilePath returns the default location of the credentials file,
+// $XDG_CONFIG_HOME/stytch/credentials, which is ~/.config/stytch/credentials when XDG_CONFIG_HOME
+// isn't set.
+func DefaultFilePath() (string, error) {
+	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+		return filepath.Join(dir, "stytch", "credentials"), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".config", "stytch", "credentials"), nil
+}
+
+// LoadProfile reads the named profile from the credentials file at filePath. If the file doesn't
+// exist, the returned error wraps os.ErrNotExist. If the profile isn't in the file, the returned
+// error wraps ErrProfileNotFound.
+func LoadProfile(filePath, profile string) (Profile, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return Profile{}, err
+	}
+
+	profiles, err := parseFile(data)
+	if err != nil {
+		return Profile{}, fmt.Errorf("parsing credentials file %s: %w", filePath, err)
+	}
+	p, ok := profiles[profile]
+	if !ok {
+		return Profile{}, fmt.Errorf("%w: %q in credentials file %s", ErrProfileNotFound, profile, filePath)
+	}
+	return p, nil
+}
+
+// Resolve returns the credentials for the profile, running its credential_process if it has one.
+func (p Profile) Resolve(ctx context.Context) (Credentials, error) {
+	if p.CredentialProcess != "" {
+		if p.WorkspaceKeyID != "" || p.WorkspaceKeySecret != "" {
+			return Credentials{}, errors.New("a profile must not set both credential_process and workspace keys")
+		}
+		return RunProcess(ctx, p.CredentialProcess)
+	}
+	if p.WorkspaceKeyID == "" || p.WorkspaceKeySecret == "" {
+		return Credentials{}, errors.New("a profile must set both workspace_key_id and workspace_key_secret, or credential_process")
+	}
+	return Credentials{
+		WorkspaceKeyID:     p.WorkspaceKeyID,
+		WorkspaceKeySecret: p.WorkspaceKeySecret,
+	}, nil
+}
+
+// RunProcess runs command through the system shell and parses the credentials it prints to stdout
+// as a JSON object with workspace_key_id and workspace_key_secret fields.
+func RunProcess(ctx context.Context, command string) (Credentials, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd.exe", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "/bin/sh", "-c", command)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return Credentials{}, fmt.Errorf("running credential_process: %w: %s", err, msg)
+		}
+		return Credentials{}, fmt.Errorf("running credential_process: %w", err)
+	}
+
+	var creds Credentials
+	if err := json.Unmarshal(stdout.Bytes(), &creds); err != nil {
+		// Don't include the output in the error, since it may contain the secret.
+		return Credentials{}, fmt.Errorf("credential_process output is not a valid JSON object: %w", err)
+	}
+	if creds.WorkspaceKeyID == "" || creds.WorkspaceKeySecret == "" {
+		return Credentials{}, errors.New("credential_process output must contain workspace_key_id and workspace_key_secret")
+	}
+	return creds, nil
+}
+
+// parseFile parses an INI-style credentials file into its profiles, keyed by name. Blank lines and
+// lines starting with # or ; are ignored.
+func parseFile(data []byte) (map[string]Profile, error) {
+	profiles := make(map[string]Profile)
+	var name string
+	var inProfile bool
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") {
+				return nil, fmt.Errorf("line %d: invalid profile header", lineNumber)
+			}
+			name = strings.TrimSpace(line[1 : len(line)-1])
+			if name == "" {
+				return nil, fmt.Errorf("line %d: empty profile name", lineNumber)
+			}
+			if _, ok := profiles[name]; ok {
+				return nil, fmt.Errorf("line %d: duplicate profile %q", lineNumber, name)
+			}
+			profiles[name] = Profile{}
+			inProfile = true
+			continue
+		}
+
+		if !inProfile {
+			return nil, fmt.Errorf("line %d: setting outside of a profile", lineNumber)
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected key = value", lineNumber)
+		}
+		key = strings.TrimSpace(key)
+		value = unquote(strings.TrimSpace(value))
+
+		p := profiles[name]
+		switch key {
+		case "workspace_key_id":
+			p.WorkspaceKeyID = value
+		case "workspace_key_secret":
+			p.WorkspaceKeySecret = value
+		case "credential_process":
+			p.CredentialProcess = value
+		default:
+			return nil, fmt.Errorf("line %d: unknown setting %q", lineNumber, key)
+		}
+		profiles[name] = p
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
+
+func unquote(s string) string {
+	if len(s) >= 2 && (s[0] == '"' && s[len(s)-1] == '"' || s[0] == '\'' && s[len(s)-1] == '\'') {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
@@ -0,0 +1,147 @@
+package credentials_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/credentials"
+)
+
+const testCredentialsFile = `
+# Comments and blank lines are ignored.
+[default]
+workspace_key_id     = workspace-key-test-default
+workspace_key_secret = "default-secret"
+
+; Profiles can also get their keys from a command.
+[prod-workspace]
+credential_process = printf '{"workspace_key_id":"workspace-key-prod","workspace_key_secret":"prod-secret"}'
+`
+
+func writeCredentialsFile(t *testing.T, content string) string {
+	t.Helper()
+	filePath := filepath.Join(t.TempDir(), "credentials")
+	if err := os.WriteFile(filePath, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return filePath
+}
+
+func TestLoadProfile(t *testing.T) {
+	filePath := writeCredentialsFile(t, testCredentialsFile)
+
+	p, err := credentials.LoadProfile(filePath, credentials.DefaultProfile)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := credentials.Profile{
+		WorkspaceKeyID:     "workspace-key-test-default",
+		WorkspaceKeySecret: "default-secret",
+	}
+	if p != expected {
+		t.Errorf("expected profile %+v, got %+v", expected, p)
+	}
+
+	p, err = credentials.LoadProfile(filePath, "prod-workspace")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected = credentials.Profile{
+		CredentialProcess: `printf '{"workspace_key_id":"workspace-key-prod","workspace_key_secret":"prod-secret"}'`,
+	}
+	if p != expected {
+		t.Errorf("expected profile %+v, got %+v", expected, p)
+	}
+
+	_, err = credentials.LoadProfile(filePath, "missing")
+	if !errors.Is(err, credentials.ErrProfileNotFound) {
+		t.Errorf("expected ErrProfileNotFound, got %v", err)
+	}
+
+	_, err = credentials.LoadProfile(filepath.Join(t.TempDir(), "missing"), credentials.DefaultProfile)
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expected os.ErrNotExist, got %v", err)
+	}
+}
+
+func TestLoadProfileInvalidFile(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		content string
+	}{
+		{name: "setting outside of a profile", content: "workspace_key_id = abc\n"},
+		{name: "unknown setting", content: "[default]\nworkspace_key = abc\n"},
+		{name: "missing equals sign", content: "[default]\nworkspace_key_id abc\n"},
+		{name: "unterminated header", content: "[default\n"},
+		{name: "duplicate profile", content: "[default]\n[default]\n"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := credentials.LoadProfile(writeCredentialsFile(t, tc.content), credentials.DefaultProfile); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestProfileResolve(t *testing.T) {
+	creds, err := credentials.Profile{
+		WorkspaceKeyID:     "id",
+		WorkspaceKeySecret: "secret",
+	}.Resolve(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := (credentials.Credentials{WorkspaceKeyID: "id", WorkspaceKeySecret: "secret"}); creds != expected {
+		t.Errorf("expected credentials %+v, got %+v", expected, creds)
+	}
+
+	if _, err := (credentials.Profile{WorkspaceKeyID: "id"}).Resolve(context.Background()); err == nil {
+		t.Error("expected an error for a profile without a secret")
+	}
+
+	_, err = credentials.Profile{
+		WorkspaceKeyID:    "id",
+		CredentialProcess: "true",
+	}.Resolve(context.Background())
+	if err == nil {
+		t.Error("expected an error for a profile with both keys and credential_process")
+	}
+}
+
+func TestRunProcess(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("credential_process tests use POSIX shell commands")
+	}
+
+	creds, err := credentials.RunProcess(context.Background(),
+		`printf '{"workspace_key_id":"workspace-key-prod","workspace_key_secret":"prod-secret"}'`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := credentials.Credentials{
+		WorkspaceKeyID:     "workspace-key-prod",
+		WorkspaceKeySecret: "prod-secret",
+	}
+	if creds != expected {
+		t.Errorf("expected credentials %+v, got %+v", expected, creds)
+	}
+
+	for _, tc := range []struct {
+		name    string
+		command string
+	}{
+		{name: "command fails", command: "echo 'vault is sealed' >&2; exit 1"},
+		{name: "output is not JSON", command: "echo not-json"},
+		{name: "output is missing the secret", command: `printf '{"workspace_key_id":"id"}'`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := credentials.RunProcess(context.Background(), tc.command); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package ephemeralresources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/secrets"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/providerdata"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &secretValueEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &secretValueEphemeralResource{}
+)
+
+func NewSecretValueEphemeralResource() ephemeral.EphemeralResource {
+	return &secretValueEphemeralResource{}
+}
+
+type secretValueEphemeralResource struct {
+	client   *api.API
+	defaults providerdata.Defaults
+}
+
+type secretValueModel struct {
+	ProjectSlug     types.String `tfsdk:"project_slug"`
+	EnvironmentSlug types.String `tfsdk:"environment_slug"`
+	SecretID        types.String `tfsdk:"secret_id"`
+	LastFour        types.String `tfsdk:"last_four"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+	UsedAt          types.String `tfsdk:"used_at"`
+}
+
+func (r *secretValueEphemeralResource) Configure(
+	_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse,
+) {
+	// Add a nil check when handling ProviderData because Terraform sets that data after it calls the
+	// ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerdata.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report "+
+				"this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = data.Client
+	r.defaults = data.Defaults
+}
+
+// Metadata returns the ephemeral resource type name.
+func (r *secretValueEphemeralResource) Metadata(
+	_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_secret_value"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (r *secretValueEphemeralResource) Schema(
+	_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Reads an existing secret for an environment without managing it and without " +
+			"persisting anything to state. Stytch only reveals the full secret value in the response " +
+			"to the request that created it, so only the masked value (its last four characters) is " +
+			"available here; consumers that need the full value must receive it from the owner of the " +
+			"`stytch_secret` resource.",
+		Attributes: map[string]schema.Attribute{
+			"project_slug": schema.StringAttribute{
+				Optional:    true,
+				Description: "The slug of the project to which the secret belongs. Defaults to the provider's `default_project_slug`.",
+			},
+			"environment_slug": schema.StringAttribute{
+				Optional:    true,
+				Description: "The slug of the environment to which the secret belongs. Defaults to the provider's `default_environment_slug`.",
+			},
+			"secret_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The unique identifier for the secret.",
+			},
+			"last_four": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The last four characters of the secret value.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ISO-8601 timestamp when the secret was created.",
+			},
+			"used_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ISO-8601 timestamp when the secret was last used, if it has been used.",
+			},
+		},
+	}
+}
+
+// Open reads the secret for the duration of the Terraform run.
+func (r *secretValueEphemeralResource) Open(
+	ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse,
+) {
+	var data secretValueModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	data.ProjectSlug = r.defaults.ApplyProjectSlug(&resp.Diagnostics, data.ProjectSlug)
+	data.EnvironmentSlug = r.defaults.ApplyEnvironmentSlug(&resp.Diagnostics, data.EnvironmentSlug)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "project_slug", data.ProjectSlug.ValueString())
+	ctx = tflog.SetField(ctx, "environment_slug", data.EnvironmentSlug.ValueString())
+	ctx = tflog.SetField(ctx, "secret_id", data.SecretID.ValueString())
+	tflog.Info(ctx, "Opening secret value")
+
+	getResp, err := r.client.Secrets.Get(ctx, secrets.GetRequest{
+		ProjectSlug:     data.ProjectSlug.ValueString(),
+		EnvironmentSlug: data.EnvironmentSlug.ValueString(),
+		SecretID:        data.SecretID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get secret", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Opened secret value")
+
+	data.LastFour = types.StringValue(getResp.Secret.LastFour)
+	data.CreatedAt = types.StringValue(getResp.Secret.CreatedAt.Format(time.RFC3339))
+	if getResp.Secret.UsedAt.IsZero() {
+		data.UsedAt = types.StringNull()
+	} else {
+		data.UsedAt = types.StringValue(getResp.Secret.UsedAt.Format(time.RFC3339))
+	}
+
+	diags = resp.Result.Set(ctx, data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,54 @@
+package ephemeralresources_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/testutil"
+)
+
+func TestAccSecretValueEphemeralResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// Ephemeral resources are only available in Terraform 1.10 and later.
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stytch": testutil.TestAccProtoV6ProviderFactories["stytch"],
+			"echo":   echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testutil.ProviderConfig + testutil.ConsumerProjectConfig + testutil.EnvironmentResource(testutil.EnvironmentResourceArgs{
+					ProjectSlug: "stytch_project.test.project_slug",
+					Name:        "Test Environment",
+				}) + `
+resource "stytch_secret" "test" {
+  project_slug     = stytch_project.test.project_slug
+  environment_slug = stytch_environment.test.environment_slug
+}
+
+ephemeral "stytch_secret_value" "test" {
+  project_slug     = stytch_secret.test.project_slug
+  environment_slug = stytch_secret.test.environment_slug
+  secret_id        = stytch_secret.test.secret_id
+}
+
+provider "echo" {
+  data = ephemeral.stytch_secret_value.test
+}
+
+resource "echo" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("echo.test", "data.secret_id", "stytch_secret.test", "secret_id"),
+					resource.TestCheckResourceAttrSet("echo.test", "data.last_four"),
+					resource.TestCheckResourceAttrSet("echo.test", "data.created_at"),
+				),
+			},
+		},
+	})
+}
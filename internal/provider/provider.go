@@ -4,9 +4,19 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -15,13 +25,21 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/actions"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/credentials"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/ephemeralresources"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/providerdata"
 	"github.com/stytchauth/terraform-provider-stytch/internal/provider/resources"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/tracing"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/transport"
 )
 
 // Ensure StytchProvider satisfies various provider interfaces.
 var (
-	_ provider.Provider              = &StytchProvider{}
-	_ provider.ProviderWithFunctions = &StytchProvider{}
+	_ provider.Provider                       = &StytchProvider{}
+	_ provider.ProviderWithFunctions          = &StytchProvider{}
+	_ provider.ProviderWithEphemeralResources = &StytchProvider{}
+	_ provider.ProviderWithActions            = &StytchProvider{}
 )
 
 // StytchProvider defines the provider implementation.
@@ -35,7 +53,30 @@ type StytchProvider struct {
 type StytchProviderModel struct {
 	WorkspaceKeyID     types.String `tfsdk:"workspace_key_id"`
 	WorkspaceKeySecret types.String `tfsdk:"workspace_key_secret"`
+	Profile            types.String `tfsdk:"profile"`
+	CredentialsFile    types.String `tfsdk:"credentials_file"`
+	CredentialProcess  types.String `tfsdk:"credential_process"`
 	BaseURI            types.String `tfsdk:"base_uri"`
+	MaxRetries         types.Int64  `tfsdk:"max_retries"`
+	RetryMinBackoff    types.String `tfsdk:"retry_min_backoff"`
+	RetryMaxBackoff    types.String `tfsdk:"retry_max_backoff"`
+
+	MaxRequestsPerSecond  types.Int64 `tfsdk:"max_requests_per_second"`
+	MaxConcurrentRequests types.Int64 `tfsdk:"max_concurrent_requests"`
+
+	DefaultProjectSlug     types.String `tfsdk:"default_project_slug"`
+	DefaultEnvironmentSlug types.String `tfsdk:"default_environment_slug"`
+
+	HTTPProxy          types.String `tfsdk:"http_proxy"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	RequestTimeout     types.String `tfsdk:"request_timeout"`
+
+	ReadOnly types.Bool `tfsdk:"read_only"`
+
+	ProtectedEnvironments types.Set  `tfsdk:"protected_environments"`
+	AllowProtectedChanges types.Bool `tfsdk:"allow_protected_changes"`
 }
 
 func (p *StytchProvider) Metadata(
@@ -60,10 +101,83 @@ func (p *StytchProvider) Schema(
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"profile": schema.StringAttribute{
+				Description: "The name of the profile in the credentials file to read the workspace key from. Only used when the workspace key isn't set in the configuration or environment. Defaults to \"default\". Can also be set with the STYTCH_PROFILE environment variable.",
+				Optional:    true,
+			},
+			"credentials_file": schema.StringAttribute{
+				Description: "The path to the credentials file. Defaults to $XDG_CONFIG_HOME/stytch/credentials, or ~/.config/stytch/credentials if XDG_CONFIG_HOME isn't set. Can also be set with the STYTCH_CREDENTIALS_FILE environment variable.",
+				Optional:    true,
+			},
+			"credential_process": schema.StringAttribute{
+				Description: "A command that prints the workspace key as a JSON object with `workspace_key_id` and `workspace_key_secret` fields, such as a 1Password or Vault helper. The command is run through the system shell each time the provider is configured. Only used when the workspace key isn't set in the configuration or environment, and takes precedence over the credentials file. Can also be set with the STYTCH_CREDENTIAL_PROCESS environment variable.",
+				Optional:    true,
+			},
 			"base_uri": schema.StringAttribute{
 				Description: "Base URI override to use instead of Stytch's API. This is used for internal testing only.",
 				Optional:    true,
 			},
+			"default_project_slug": schema.StringAttribute{
+				Description: "The project slug used by resources, ephemeral resources and actions that omit `project_slug`. Can also be set with the STYTCH_DEFAULT_PROJECT_SLUG environment variable.",
+				Optional:    true,
+			},
+			"default_environment_slug": schema.StringAttribute{
+				Description: "The environment slug used by resources, ephemeral resources and actions that omit `environment_slug`. Can also be set with the STYTCH_DEFAULT_ENVIRONMENT_SLUG environment variable.",
+				Optional:    true,
+			},
+			"http_proxy": schema.StringAttribute{
+				Description: "The URL of a proxy to send requests to the Stytch API through, such as \"http://proxy.internal:3128\". Defaults to the proxy set by the HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables. Can also be set with the STYTCH_HTTP_PROXY environment variable.",
+				Optional:    true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: "The path to a file of PEM-encoded CA certificates to trust in addition to the system ones, such as the certificate of a TLS-intercepting proxy. Can also be set with the STYTCH_CA_CERT_FILE environment variable.",
+				Optional:    true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Description: "PEM-encoded CA certificates to trust in addition to the system ones. Can be combined with `ca_cert_file`.",
+				Optional:    true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Whether to skip verification of the Stytch API's TLS certificate. This is insecure and should only be used against a local stand-in for the API. Defaults to false. Can also be set with the STYTCH_INSECURE_SKIP_VERIFY environment variable.",
+				Optional:    true,
+			},
+			"request_timeout": schema.StringAttribute{
+				Description: "The maximum time a single attempt of a request to the Stytch API may take, as a duration such as \"30s\". An idempotent request that times out is retried according to `max_retries`. Defaults to 0, which means no timeout. Can also be set with the STYTCH_REQUEST_TIMEOUT environment variable.",
+				Optional:    true,
+			},
+			"read_only": schema.BoolAttribute{
+				Description: "Whether the provider is limited to reading from the Stytch API. Reads, imports and plans work as usual, but creating, updating or deleting resources and invoking actions fail before the API is contacted. Use this to run plans against production from untrusted pipelines. Defaults to false. Can also be set with the STYTCH_READ_ONLY environment variable.",
+				Optional:    true,
+			},
+			"protected_environments": schema.SetAttribute{
+				Description: "The environments guarded against accidental changes. Each entry is an environment slug, which protects the environment with that slug in every project, a `project_slug.environment_slug` pair, or `LIVE`, which protects every live environment. Plans that change resources in a protected environment warn, and plans that destroy or replace them fail unless `allow_protected_changes` is set. Can also be set with the STYTCH_PROTECTED_ENVIRONMENTS environment variable, as a comma-separated list.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"allow_protected_changes": schema.BoolAttribute{
+				Description: "Whether plans may destroy or replace resources in the environments listed in `protected_environments`. Defaults to false. Can also be set with the STYTCH_ALLOW_PROTECTED_CHANGES environment variable.",
+				Optional:    true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "The maximum number of times a request is retried after a transient failure. Rate-limited requests are always retried, while network errors and 5xx responses are only retried for idempotent requests. Set to 0 to disable retries. Defaults to 3. Can also be set with the STYTCH_MAX_RETRIES environment variable.",
+				Optional:    true,
+			},
+			"retry_min_backoff": schema.StringAttribute{
+				Description: "The delay before the first retry, as a duration such as \"1s\". Each subsequent retry doubles the delay. A Retry-After header returned by the API takes precedence, up to 2 minutes. Defaults to 1s. Can also be set with the STYTCH_RETRY_MIN_BACKOFF environment variable.",
+				Optional:    true,
+			},
+			"retry_max_backoff": schema.StringAttribute{
+				Description: "The maximum delay between retries, as a duration such as \"30s\". Defaults to 30s. Can also be set with the STYTCH_RETRY_MAX_BACKOFF environment variable.",
+				Optional:    true,
+			},
+			"max_requests_per_second": schema.Int64Attribute{
+				Description: "The maximum number of requests per second the provider sends to the Stytch API, shared across all resources. Requests over the limit wait instead of failing. Defaults to 0, which means no limit. Can also be set with the STYTCH_MAX_REQUESTS_PER_SECOND environment variable.",
+				Optional:    true,
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Description: "The maximum number of requests the provider has in flight to the Stytch API at once, shared across all resources. Defaults to 0, which means no limit. Can also be set with the STYTCH_MAX_CONCURRENT_REQUESTS environment variable.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -96,6 +210,31 @@ func (p *StytchProvider) Configure(
 		)
 	}
 
+	if config.Profile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("profile"),
+			"Unknown profile",
+			"The provider cannot create the Stytch management client as there is an unknown configuration value for the credentials profile. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the STYTCH_PROFILE environment variable.",
+		)
+	}
+	if config.CredentialsFile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("credentials_file"),
+			"Unknown credentials file",
+			"The provider cannot create the Stytch management client as there is an unknown configuration value for the credentials file. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the STYTCH_CREDENTIALS_FILE environment variable.",
+		)
+	}
+	if config.CredentialProcess.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("credential_process"),
+			"Unknown credential process",
+			"The provider cannot create the Stytch management client as there is an unknown configuration value for the credential process. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the STYTCH_CREDENTIAL_PROCESS environment variable.",
+		)
+	}
+
 	if config.BaseURI.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("base_uri"),
@@ -105,6 +244,133 @@ func (p *StytchProvider) Configure(
 		)
 	}
 
+	if config.DefaultProjectSlug.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_project_slug"),
+			"Unknown default project slug",
+			"The provider cannot create the Stytch management client as there is an unknown configuration value for the default project slug. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the STYTCH_DEFAULT_PROJECT_SLUG environment variable.",
+		)
+	}
+	if config.DefaultEnvironmentSlug.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_environment_slug"),
+			"Unknown default environment slug",
+			"The provider cannot create the Stytch management client as there is an unknown configuration value for the default environment slug. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the STYTCH_DEFAULT_ENVIRONMENT_SLUG environment variable.",
+		)
+	}
+
+	if config.HTTPProxy.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("http_proxy"),
+			"Unknown HTTP proxy",
+			"The provider cannot create the Stytch management client as there is an unknown configuration value for the HTTP proxy. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the STYTCH_HTTP_PROXY environment variable.",
+		)
+	}
+	if config.CACertFile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ca_cert_file"),
+			"Unknown CA certificate file",
+			"The provider cannot create the Stytch management client as there is an unknown configuration value for the CA certificate file. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the STYTCH_CA_CERT_FILE environment variable.",
+		)
+	}
+	if config.CACertPEM.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ca_cert_pem"),
+			"Unknown CA certificate PEM",
+			"The provider cannot create the Stytch management client as there is an unknown configuration value for the CA certificate PEM. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+	if config.InsecureSkipVerify.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("insecure_skip_verify"),
+			"Unknown insecure skip verify",
+			"The provider cannot create the Stytch management client as there is an unknown configuration value for the TLS certificate verification setting. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the STYTCH_INSECURE_SKIP_VERIFY environment variable.",
+		)
+	}
+	if config.RequestTimeout.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("request_timeout"),
+			"Unknown request timeout",
+			"The provider cannot create the Stytch management client as there is an unknown configuration value for the request timeout. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the STYTCH_REQUEST_TIMEOUT environment variable.",
+		)
+	}
+
+	if config.ReadOnly.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("read_only"),
+			"Unknown read only",
+			"The provider cannot create the Stytch management client as there is an unknown configuration value for read-only mode. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the STYTCH_READ_ONLY environment variable.",
+		)
+	}
+
+	if config.ProtectedEnvironments.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("protected_environments"),
+			"Unknown protected environments",
+			"The provider cannot create the Stytch management client as there is an unknown configuration value for the protected environments. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the STYTCH_PROTECTED_ENVIRONMENTS environment variable.",
+		)
+	}
+
+	if config.AllowProtectedChanges.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("allow_protected_changes"),
+			"Unknown allow protected changes",
+			"The provider cannot create the Stytch management client as there is an unknown configuration value for allowing protected changes. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the STYTCH_ALLOW_PROTECTED_CHANGES environment variable.",
+		)
+	}
+
+	if config.MaxRetries.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_retries"),
+			"Unknown max retries",
+			"The provider cannot create the Stytch management client as there is an unknown configuration value for the maximum number of retries. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the STYTCH_MAX_RETRIES environment variable.",
+		)
+	}
+	if config.RetryMinBackoff.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_min_backoff"),
+			"Unknown retry min backoff",
+			"The provider cannot create the Stytch management client as there is an unknown configuration value for the minimum retry backoff. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the STYTCH_RETRY_MIN_BACKOFF environment variable.",
+		)
+	}
+	if config.RetryMaxBackoff.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_max_backoff"),
+			"Unknown retry max backoff",
+			"The provider cannot create the Stytch management client as there is an unknown configuration value for the maximum retry backoff. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the STYTCH_RETRY_MAX_BACKOFF environment variable.",
+		)
+	}
+
+	if config.MaxRequestsPerSecond.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_requests_per_second"),
+			"Unknown max requests per second",
+			"The provider cannot create the Stytch management client as there is an unknown configuration value for the maximum requests per second. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the STYTCH_MAX_REQUESTS_PER_SECOND environment variable.",
+		)
+	}
+	if config.MaxConcurrentRequests.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_concurrent_requests"),
+			"Unknown max concurrent requests",
+			"The provider cannot create the Stytch management client as there is an unknown configuration value for the maximum concurrent requests. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the STYTCH_MAX_CONCURRENT_REQUESTS environment variable.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -126,13 +392,24 @@ func (p *StytchProvider) Configure(
 		baseURI = config.BaseURI.ValueString()
 	}
 
+	// If no workspace key was given directly, look it up with the credential process or in the
+	// credentials file.
+	if workspaceKeyID == "" && workspaceKeySecret == "" {
+		creds := externalCredentials(ctx, config, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		workspaceKeyID = creds.WorkspaceKeyID
+		workspaceKeySecret = creds.WorkspaceKeySecret
+	}
+
 	// Now we make sure the keyID and secret are not empty strings
 	if workspaceKeyID == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("workspace_key_id"),
 			"Missing workspace key ID",
 			"The provider cannot create the Stytch management client as there is a missing or empty value for the Stytch Workspace Key ID. "+
-				"Set the value in the configuration or use the STYTCH_WORKSPACE_KEY_ID environment variable. "+
+				"Set the value in the configuration, use the STYTCH_WORKSPACE_KEY_ID environment variable, or configure a credentials profile or credential process. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
@@ -141,15 +418,60 @@ func (p *StytchProvider) Configure(
 			path.Root("workspace_key_secret"),
 			"Missing workspace key secret",
 			"The provider cannot create the Stytch management client as there is a missing or empty value for the Stytch Workspace Key Secret. "+
-				"Set the value in the configuration or use the STYTCH_WORKSPACE_KEY_SECRET environment variable. "+
+				"Set the value in the configuration, use the STYTCH_WORKSPACE_KEY_SECRET environment variable, or configure a credentials profile or credential process. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
 
+	retryPolicy := transport.DefaultRetryPolicy()
+	retryPolicy.MaxRetries = intSetting(&resp.Diagnostics, path.Root("max_retries"),
+		"STYTCH_MAX_RETRIES", config.MaxRetries, retryPolicy.MaxRetries)
+	retryPolicy.MinBackoff = durationSetting(&resp.Diagnostics, path.Root("retry_min_backoff"),
+		"STYTCH_RETRY_MIN_BACKOFF", config.RetryMinBackoff, retryPolicy.MinBackoff)
+	retryPolicy.MaxBackoff = durationSetting(&resp.Diagnostics, path.Root("retry_max_backoff"),
+		"STYTCH_RETRY_MAX_BACKOFF", config.RetryMaxBackoff, retryPolicy.MaxBackoff)
+	if retryPolicy.MinBackoff > retryPolicy.MaxBackoff {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_min_backoff"),
+			"Invalid retry backoff",
+			"The minimum retry backoff must not be greater than the maximum retry backoff.",
+		)
+	}
+
+	maxRequestsPerSecond := intSetting(&resp.Diagnostics, path.Root("max_requests_per_second"),
+		"STYTCH_MAX_REQUESTS_PER_SECOND", config.MaxRequestsPerSecond, 0)
+	maxConcurrentRequests := intSetting(&resp.Diagnostics, path.Root("max_concurrent_requests"),
+		"STYTCH_MAX_CONCURRENT_REQUESTS", config.MaxConcurrentRequests, 0)
+
+	baseTransportConfig, caCertSources := baseTransportSetting(&resp.Diagnostics, config)
+	readOnly := boolSetting(&resp.Diagnostics, path.Root("read_only"), "STYTCH_READ_ONLY", config.ReadOnly)
+	protectedEnvironments := setSetting(ctx, &resp.Diagnostics, "STYTCH_PROTECTED_ENVIRONMENTS",
+		config.ProtectedEnvironments)
+	allowProtectedChanges := boolSetting(&resp.Diagnostics, path.Root("allow_protected_changes"),
+		"STYTCH_ALLOW_PROTECTED_CHANGES", config.AllowProtectedChanges)
+	requestTimeout := durationSetting(&resp.Diagnostics, path.Root("request_timeout"),
+		"STYTCH_REQUEST_TIMEOUT", config.RequestTimeout, 0)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	baseTransport, err := transport.NewBaseTransport(baseTransportConfig)
+	if err != nil {
+		var invalidCACert *transport.InvalidCACertError
+		if errors.As(err, &invalidCACert) {
+			source := caCertSources[invalidCACert.Index]
+			resp.Diagnostics.AddAttributeError(source.attribute, "Invalid CA certificates",
+				fmt.Sprintf("The CA certificates of %s are invalid: %s", source.setting, err.Error()))
+			return
+		}
+		resp.Diagnostics.AddError("Failed to create the HTTP transport", err.Error())
+		return
+	}
+	if baseTransportConfig.InsecureSkipVerify {
+		tflog.Warn(ctx, "TLS certificate verification of the Stytch API is disabled")
+	}
+
 	ctx = tflog.SetField(ctx, "workspace_key_id", workspaceKeyID)
 	ctx = tflog.SetField(ctx, "workspace_key_secret", workspaceKeySecret)
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "workspace_key_secret")
@@ -160,19 +482,268 @@ func (p *StytchProvider) Configure(
 	var opts []api.APIOption
 
 	opts = append(opts, api.WithUserAgentSuffix("terraform-provider-stytch/"+p.version))
+	// Every attempt of a retried request goes through the throttle, so retries also count towards
+	// the rate and concurrency limits, and gets its own timeout, log entry and span.
+	var httpTransport http.RoundTripper = transport.NewRetryTransport(
+		transport.NewThrottleTransport(
+			transport.NewTracingTransport(
+				transport.NewLoggingTransport(transport.NewTimeoutTransport(baseTransport, requestTimeout)),
+				tracing.Tracer(),
+			),
+			maxRequestsPerSecond, maxConcurrentRequests,
+		),
+		retryPolicy,
+	)
+	if readOnly {
+		// Resources and actions refuse changes themselves, this guarantees that nothing else can.
+		tflog.Info(ctx, "The Stytch provider is in read-only mode")
+		httpTransport = transport.NewReadOnlyTransport(httpTransport)
+	}
+	opts = append(opts, api.WithHTTPClient(&http.Client{Transport: httpTransport}))
 	if baseURI != "" {
 		ctx = tflog.SetField(ctx, "base_uri", baseURI)
 		opts = append(opts, api.WithBaseURI(baseURI))
 	}
 	client := api.NewClient(workspaceKeyID, workspaceKeySecret, opts...)
 
-	// Make the client available to the provider.
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	data := &providerdata.ProviderData{
+		Client: client,
+		Defaults: providerdata.Defaults{
+			ProjectSlug:     stringSetting("STYTCH_DEFAULT_PROJECT_SLUG", config.DefaultProjectSlug),
+			EnvironmentSlug: stringSetting("STYTCH_DEFAULT_ENVIRONMENT_SLUG", config.DefaultEnvironmentSlug),
+		},
+		ReadOnly:   readOnly,
+		Protection: providerdata.NewProtection(client, protectedEnvironments, allowProtectedChanges),
+		Verticals:  providerdata.NewVerticals(client),
+	}
+
+	// Make the client and defaults available to the provider.
+	resp.DataSourceData = data
+	resp.ResourceData = data
+	resp.EphemeralResourceData = data
+	resp.ActionData = data
 
 	tflog.Info(ctx, "Stytch provider configured", map[string]any{"success": true})
 }
 
+// externalCredentials resolves the workspace key from the credential process if one is configured,
+// and otherwise from the selected profile of the credentials file. It returns empty credentials if
+// neither is configured and the default credentials file doesn't have a default profile.
+func externalCredentials(
+	ctx context.Context, config StytchProviderModel, diags *diag.Diagnostics,
+) credentials.Credentials {
+	if credentialProcess := stringSetting("STYTCH_CREDENTIAL_PROCESS", config.CredentialProcess); credentialProcess != "" {
+		tflog.Debug(ctx, "Running credential process")
+		creds, err := credentials.RunProcess(ctx, credentialProcess)
+		if err != nil {
+			diags.AddAttributeError(path.Root("credential_process"), "Failed to run credential process", err.Error())
+			return credentials.Credentials{}
+		}
+		return creds
+	}
+
+	profile := stringSetting("STYTCH_PROFILE", config.Profile)
+	filePath := stringSetting("STYTCH_CREDENTIALS_FILE", config.CredentialsFile)
+	// The default credentials file and profile are optional, but a profile or file the practitioner
+	// asked for must exist.
+	explicit := profile != "" || filePath != ""
+	if profile == "" {
+		profile = credentials.DefaultProfile
+	}
+	if filePath == "" {
+		var err error
+		filePath, err = credentials.DefaultFilePath()
+		if err != nil {
+			if explicit {
+				diags.AddAttributeError(path.Root("credentials_file"), "Failed to find credentials file", err.Error())
+			}
+			return credentials.Credentials{}
+		}
+	}
+
+	ctx = tflog.SetField(ctx, "profile", profile)
+	ctx = tflog.SetField(ctx, "credentials_file", filePath)
+	tflog.Debug(ctx, "Loading credentials profile")
+
+	p, err := credentials.LoadProfile(filePath, profile)
+	if err != nil {
+		if !explicit && (errors.Is(err, os.ErrNotExist) || errors.Is(err, credentials.ErrProfileNotFound)) {
+			return credentials.Credentials{}
+		}
+		diags.AddAttributeError(path.Root("profile"), "Failed to load credentials profile", err.Error())
+		return credentials.Credentials{}
+	}
+	creds, err := p.Resolve(ctx)
+	if err != nil {
+		diags.AddAttributeError(path.Root("profile"), "Failed to load credentials profile",
+			"Profile "+profile+" in "+filePath+": "+err.Error())
+		return credentials.Credentials{}
+	}
+	return creds
+}
+
+// caCertSource is the setting an entry of transport.BaseTransportConfig.CACertsPEM comes from.
+type caCertSource struct {
+	// attribute is the provider attribute of the setting.
+	attribute path.Path
+	// setting describes the setting in diagnostics.
+	setting string
+}
+
+// baseTransportSetting resolves the proxy and TLS settings from the provider configuration, falling
+// back to their environment variables. It also returns the source of each CA bundle, in the order
+// of CACertsPEM.
+func baseTransportSetting(
+	diags *diag.Diagnostics, config StytchProviderModel,
+) (transport.BaseTransportConfig, []caCertSource) {
+	var cfg transport.BaseTransportConfig
+	var caCertSources []caCertSource
+
+	if raw := stringSetting("STYTCH_HTTP_PROXY", config.HTTPProxy); raw != "" {
+		proxyURL, err := url.Parse(raw)
+		if err != nil || proxyURL.Scheme == "" || proxyURL.Host == "" {
+			diags.AddAttributeError(
+				path.Root("http_proxy"),
+				"Invalid HTTP proxy",
+				"The HTTP proxy must be an absolute URL such as \"http://proxy.internal:3128\".",
+			)
+		} else {
+			cfg.ProxyURL = proxyURL
+		}
+	}
+
+	if caCertFile := stringSetting("STYTCH_CA_CERT_FILE", config.CACertFile); caCertFile != "" {
+		setting := "ca_cert_file"
+		if config.CACertFile.IsNull() {
+			setting = "the STYTCH_CA_CERT_FILE environment variable"
+		}
+		pem, err := os.ReadFile(caCertFile)
+		if err != nil {
+			diags.AddAttributeError(path.Root("ca_cert_file"), "Failed to read CA certificate file",
+				fmt.Sprintf("Failed to read the file %q of %s: %s", caCertFile, setting, err.Error()))
+		} else {
+			cfg.CACertsPEM = append(cfg.CACertsPEM, pem)
+			caCertSources = append(caCertSources, caCertSource{
+				attribute: path.Root("ca_cert_file"),
+				setting:   fmt.Sprintf("the file %q of %s", caCertFile, setting),
+			})
+		}
+	}
+	if !config.CACertPEM.IsNull() && config.CACertPEM.ValueString() != "" {
+		cfg.CACertsPEM = append(cfg.CACertsPEM, []byte(config.CACertPEM.ValueString()))
+		caCertSources = append(caCertSources, caCertSource{attribute: path.Root("ca_cert_pem"), setting: "ca_cert_pem"})
+	}
+
+	cfg.InsecureSkipVerify = boolSetting(diags, path.Root("insecure_skip_verify"),
+		"STYTCH_INSECURE_SKIP_VERIFY", config.InsecureSkipVerify)
+
+	return cfg, caCertSources
+}
+
+// boolSetting resolves a boolean setting from the provider configuration, falling back to the given
+// environment variable and then to false.
+func boolSetting(diags *diag.Diagnostics, attrPath path.Path, envVar string, value types.Bool) bool {
+	if !value.IsNull() {
+		return value.ValueBool()
+	}
+	raw := os.Getenv(envVar)
+	if raw == "" {
+		return false
+	}
+	b, err := strconv.ParseBool(raw)
+	if err != nil {
+		diags.AddAttributeError(
+			attrPath,
+			"Invalid boolean",
+			"The "+envVar+" environment variable must be a boolean: "+err.Error(),
+		)
+		return false
+	}
+	return b
+}
+
+// setSetting resolves a set of strings from the provider configuration, falling back to the given
+// environment variable holding a comma-separated list.
+func setSetting(ctx context.Context, diags *diag.Diagnostics, envVar string, value types.Set) []string {
+	var values []string
+	if !value.IsNull() {
+		diags.Append(value.ElementsAs(ctx, &values, false)...)
+		return values
+	}
+	for _, v := range strings.Split(os.Getenv(envVar), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// stringSetting resolves a string setting from the provider configuration, falling back to the
+// given environment variable.
+func stringSetting(envVar string, value types.String) string {
+	if !value.IsNull() {
+		return value.ValueString()
+	}
+	return os.Getenv(envVar)
+}
+
+// intSetting resolves a non-negative integer setting from the provider configuration, falling back
+// to the given environment variable and then to the default value.
+func intSetting(
+	diags *diag.Diagnostics, attrPath path.Path, envVar string, value types.Int64, defaultValue int,
+) int {
+	n := defaultValue
+	if raw := os.Getenv(envVar); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil {
+			diags.AddAttributeError(
+				attrPath,
+				"Invalid integer",
+				"The "+envVar+" environment variable must be an integer: "+err.Error(),
+			)
+			return defaultValue
+		}
+		n = parsed
+	}
+	if !value.IsNull() {
+		n = int(value.ValueInt64())
+	}
+	if n < 0 {
+		diags.AddAttributeError(attrPath, "Invalid integer", "The value must not be negative.")
+		return defaultValue
+	}
+	return n
+}
+
+// durationSetting resolves a duration setting from the provider configuration, falling back to the
+// given environment variable and then to the default value.
+func durationSetting(
+	diags *diag.Diagnostics, attrPath path.Path, envVar string, value types.String, defaultValue time.Duration,
+) time.Duration {
+	raw := os.Getenv(envVar)
+	if !value.IsNull() {
+		raw = value.ValueString()
+	}
+	if raw == "" {
+		return defaultValue
+	}
+
+	d, err := time.ParseDuration(raw)
+	if err != nil {
+		diags.AddAttributeError(
+			attrPath,
+			"Invalid duration",
+			"The value must be a duration such as \"1s\" or \"500ms\" (set in the configuration or with the "+envVar+" environment variable): "+err.Error(),
+		)
+		return defaultValue
+	}
+	if d < 0 {
+		diags.AddAttributeError(attrPath, "Invalid duration", "The duration must not be negative.")
+		return defaultValue
+	}
+	return d
+}
+
 func (p *StytchProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		resources.NewB2BSDKConfigResource,
@@ -188,11 +759,28 @@ func (p *StytchProvider) Resources(_ context.Context) []func() resource.Resource
 		resources.NewPublicTokenResource,
 		resources.NewRBACPolicyResource,
 		resources.NewRedirectURLResource,
+		resources.NewRedirectURLsResource,
 		resources.NewSecretResource,
 		resources.NewTrustedTokenProfileResource,
 	}
 }
 
+func (p *StytchProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		ephemeralresources.NewSecretValueEphemeralResource,
+	}
+}
+
+func (p *StytchProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		actions.NewB2BSDKEnabledAction,
+		actions.NewConsumerSDKEnabledAction,
+		actions.NewCountryCodeAllowlistResetAction,
+		actions.NewJWTTemplateResetAction,
+		actions.NewPasswordConfigResetAction,
+	}
+}
+
 func (p *StytchProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return nil
 }
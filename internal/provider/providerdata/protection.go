@@ -0,0 +1,99 @@
+package providerdata
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/environments"
+)
+
+// ProtectLiveEnvironments is the protected_environments entry that protects every live
+// environment.
+const ProtectLiveEnvironments = "LIVE"
+
+// Protection decides which environments are protected against destructive changes. Its entries
+// are environment slugs, which protect the environment with that slug in every project,
+// "project_slug.environment_slug" pairs, or ProtectLiveEnvironments.
+type Protection struct {
+	// AllowChanges allows destroying and replacing resources in protected environments.
+	AllowChanges bool
+
+	client  *api.API
+	entries map[string]bool
+	live    bool
+
+	mu sync.Mutex
+	// liveEnvironments caches whether an environment, keyed by project and environment slug, is a
+	// live environment.
+	liveEnvironments map[string]bool
+}
+
+// NewProtection returns a Protection for the given protected_environments entries.
+func NewProtection(client *api.API, entries []string, allowChanges bool) *Protection {
+	p := &Protection{
+		AllowChanges:     allowChanges,
+		client:           client,
+		entries:          make(map[string]bool),
+		liveEnvironments: make(map[string]bool),
+	}
+	for _, entry := range entries {
+		if strings.EqualFold(entry, ProtectLiveEnvironments) {
+			p.live = true
+		} else {
+			p.entries[entry] = true
+		}
+	}
+	return p
+}
+
+// Enabled reports whether any environment is protected.
+func (p *Protection) Enabled() bool {
+	return p != nil && (p.live || len(p.entries) > 0)
+}
+
+// IsProtected reports whether the environment is protected. knownLive tells that the environment is
+// a live environment, such as a project's live environment, so the API doesn't need to be asked.
+func (p *Protection) IsProtected(
+	ctx context.Context, projectSlug, environmentSlug string, knownLive bool,
+) (bool, error) {
+	if !p.Enabled() {
+		return false, nil
+	}
+	if p.entries[environmentSlug] || p.entries[projectSlug+"."+environmentSlug] {
+		return true, nil
+	}
+	if !p.live {
+		return false, nil
+	}
+	if knownLive {
+		return true, nil
+	}
+	return p.isLive(ctx, projectSlug, environmentSlug)
+}
+
+func (p *Protection) isLive(ctx context.Context, projectSlug, environmentSlug string) (bool, error) {
+	key := projectSlug + "." + environmentSlug
+
+	p.mu.Lock()
+	live, ok := p.liveEnvironments[key]
+	p.mu.Unlock()
+	if ok {
+		return live, nil
+	}
+
+	getResp, err := p.client.Environments.Get(ctx, environments.GetRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: environmentSlug,
+	})
+	if err != nil {
+		return false, err
+	}
+	live = getResp.Environment.Type == environments.EnvironmentTypeLive
+
+	p.mu.Lock()
+	p.liveEnvironments[key] = live
+	p.mu.Unlock()
+	return live, nil
+}
@@ -0,0 +1,82 @@
+package providerdata
+
+import (
+	"context"
+	"testing"
+)
+
+func TestProtectionIsProtected(t *testing.T) {
+	for _, tc := range []struct {
+		name            string
+		entries         []string
+		projectSlug     string
+		environmentSlug string
+		knownLive       bool
+		expected        bool
+	}{
+		{
+			name:            "no entries",
+			projectSlug:     "my-project",
+			environmentSlug: "production",
+		},
+		{
+			name:            "environment slug",
+			entries:         []string{"production"},
+			projectSlug:     "my-project",
+			environmentSlug: "production",
+			expected:        true,
+		},
+		{
+			name:            "project and environment slug",
+			entries:         []string{"my-project.staging"},
+			projectSlug:     "my-project",
+			environmentSlug: "staging",
+			expected:        true,
+		},
+		{
+			name:            "other project",
+			entries:         []string{"my-project.staging"},
+			projectSlug:     "other-project",
+			environmentSlug: "staging",
+		},
+		{
+			name:            "known live environment",
+			entries:         []string{"live"},
+			projectSlug:     "my-project",
+			environmentSlug: "production",
+			knownLive:       true,
+			expected:        true,
+		},
+		{
+			name:            "live protection doesn't cover other slugs",
+			entries:         []string{"production"},
+			projectSlug:     "my-project",
+			environmentSlug: "staging",
+			knownLive:       true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := NewProtection(nil, tc.entries, false)
+			protected, err := p.IsProtected(context.Background(), tc.projectSlug, tc.environmentSlug, tc.knownLive)
+			if err != nil {
+				t.Fatalf("IsProtected returned an error: %v", err)
+			}
+			if protected != tc.expected {
+				t.Errorf("IsProtected(%q, %q) = %v, want %v", tc.projectSlug, tc.environmentSlug, protected, tc.expected)
+			}
+		})
+	}
+}
+
+func TestProtectionEnabled(t *testing.T) {
+	var p *Protection
+	if p.Enabled() {
+		t.Error("a nil Protection is enabled")
+	}
+	if NewProtection(nil, nil, true).Enabled() {
+		t.Error("a Protection without entries is enabled")
+	}
+	if !NewProtection(nil, []string{ProtectLiveEnvironments}, false).Enabled() {
+		t.Error("a Protection of live environments isn't enabled")
+	}
+}
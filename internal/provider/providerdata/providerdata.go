@@ -0,0 +1,87 @@
+package providerdata
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+)
+
+// Names of the provider attributes that set the defaults.
+const (
+	DefaultProjectSlugAttribute     = "default_project_slug"
+	DefaultEnvironmentSlugAttribute = "default_environment_slug"
+)
+
+// ProviderData is passed from the provider to resources, ephemeral resources and actions when the
+// provider is configured.
+type ProviderData struct {
+	Client   *api.API
+	Defaults Defaults
+	// ReadOnly is set when the provider must not make any changes through the Stytch API.
+	ReadOnly bool
+	// Protection guards protected environments against destructive changes.
+	Protection *Protection
+	// Verticals looks up the verticals of projects to check resources against them when planning.
+	Verticals *Verticals
+}
+
+// Defaults holds provider-level values for attributes that are omitted from a resource's
+// configuration. Empty strings mean no default is set.
+type Defaults struct {
+	ProjectSlug     string
+	EnvironmentSlug string
+}
+
+// ApplyProjectSlug returns value, or the default project slug if value is null. It adds an error to
+// diags if neither is set.
+func (d Defaults) ApplyProjectSlug(diags *diag.Diagnostics, value types.String) types.String {
+	return applyDefault(diags, path.Root("project_slug"), value, d.ProjectSlug, DefaultProjectSlugAttribute)
+}
+
+// ApplyEnvironmentSlug returns value, or the default environment slug if value is null. It adds an
+// error to diags if neither is set.
+func (d Defaults) ApplyEnvironmentSlug(diags *diag.Diagnostics, value types.String) types.String {
+	return applyDefault(diags, path.Root("environment_slug"), value, d.EnvironmentSlug, DefaultEnvironmentSlugAttribute)
+}
+
+func applyDefault(
+	diags *diag.Diagnostics, attrPath path.Path, value types.String, defaultValue, providerAttribute string,
+) types.String {
+	if !value.IsNull() {
+		return value
+	}
+	if defaultValue == "" {
+		AddMissingDefaultError(diags, attrPath, providerAttribute)
+		return value
+	}
+	return types.StringValue(defaultValue)
+}
+
+// AddMissingDefaultError adds an error to diags for an attribute that is omitted from the
+// configuration while the provider has no default for it.
+func AddMissingDefaultError(diags *diag.Diagnostics, attrPath path.Path, providerAttribute string) {
+	diags.AddAttributeError(
+		attrPath,
+		"Missing "+attrPath.String(),
+		fmt.Sprintf("The %s attribute must be set, either in the configuration or with the %s attribute of the provider.",
+			attrPath, providerAttribute),
+	)
+}
+
+// CheckWritable adds an error to diags and returns false if the provider is in read-only mode, so
+// that a change is refused before the Stytch API is contacted. operation describes the refused
+// change, such as "create this resource".
+func CheckWritable(readOnly bool, diags *diag.Diagnostics, operation string) bool {
+	if !readOnly {
+		return true
+	}
+	diags.AddError(
+		"Provider is read-only",
+		fmt.Sprintf("Refusing to %s: the Stytch provider is in read-only mode because of its read_only attribute or the STYTCH_READ_ONLY environment variable. "+
+			"Reads, imports and plans still work, but no changes are made through the Stytch API.", operation),
+	)
+	return false
+}
@@ -0,0 +1,55 @@
+package providerdata
+
+import (
+	"context"
+	"sync"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
+)
+
+// Verticals looks up the verticals of projects. A project's vertical can't change, so each project
+// is only looked up once per run of the provider.
+type Verticals struct {
+	client *api.API
+
+	mu sync.Mutex
+	// verticals caches the vertical of a project, keyed by project slug.
+	verticals map[string]projects.Vertical
+}
+
+// NewVerticals returns a Verticals that looks up projects with client.
+func NewVerticals(client *api.API) *Verticals {
+	return &Verticals{
+		client:    client,
+		verticals: make(map[string]projects.Vertical),
+	}
+}
+
+// Get returns the vertical of the project. It returns an empty vertical if v is nil, which is the
+// case of resources whose provider isn't configured yet.
+func (v *Verticals) Get(ctx context.Context, projectSlug string) (projects.Vertical, error) {
+	if v == nil {
+		return "", nil
+	}
+
+	v.mu.Lock()
+	vertical, ok := v.verticals[projectSlug]
+	v.mu.Unlock()
+	if ok {
+		return vertical, nil
+	}
+
+	getResp, err := v.client.Projects.Get(ctx, projects.GetRequest{
+		ProjectSlug: projectSlug,
+	})
+	if err != nil {
+		return "", err
+	}
+	vertical = getResp.Project.Vertical
+
+	v.mu.Lock()
+	v.verticals[projectSlug] = vertical
+	v.mu.Unlock()
+	return vertical, nil
+}
@@ -0,0 +1,27 @@
+package providerdata
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
+)
+
+func TestVerticalsGet(t *testing.T) {
+	var unconfigured *Verticals
+	vertical, err := unconfigured.Get(context.Background(), "my-project")
+	if err != nil || vertical != "" {
+		t.Errorf("Get on a nil Verticals = %q, %v, want no vertical and no error", vertical, err)
+	}
+
+	// Cached verticals are returned without asking the API, which a nil client would fail to.
+	v := NewVerticals(nil)
+	v.verticals["my-project"] = projects.VerticalB2B
+	vertical, err = v.Get(context.Background(), "my-project")
+	if err != nil {
+		t.Fatalf("Get returned an error: %v", err)
+	}
+	if vertical != projects.VerticalB2B {
+		t.Errorf("Get(%q) = %q, want %q", "my-project", vertical, projects.VerticalB2B)
+	}
+}
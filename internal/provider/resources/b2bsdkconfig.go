@@ -3,7 +3,6 @@ package resources
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -25,6 +24,8 @@ import (
 	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
 	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
 	"github.com/stytchauth/stytch-management-go/v3/pkg/models/sdk"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/providerdata"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/tracing"
 	"github.com/stytchauth/terraform-provider-stytch/internal/provider/utils"
 )
 
@@ -34,6 +35,8 @@ var (
 	_ resource.ResourceWithConfigure    = &b2bSDKConfigResource{}
 	_ resource.ResourceWithImportState  = &b2bSDKConfigResource{}
 	_ resource.ResourceWithUpgradeState = &b2bSDKConfigResource{}
+	_ resource.ResourceWithModifyPlan   = &b2bSDKConfigResource{}
+	_ resource.ResourceWithIdentity     = &b2bSDKConfigResource{}
 )
 
 func NewB2BSDKConfigResource() resource.Resource {
@@ -41,13 +44,18 @@ func NewB2BSDKConfigResource() resource.Resource {
 }
 
 type b2bSDKConfigResource struct {
-	client *api.API
+	client     *api.API
+	defaults   providerdata.Defaults
+	readOnly   bool
+	protection *providerdata.Protection
+	verticals  *providerdata.Verticals
 }
 
 type b2bSDKConfigModel struct {
 	ID              types.String `tfsdk:"id"`
 	ProjectSlug     types.String `tfsdk:"project_slug"`
 	EnvironmentSlug types.String `tfsdk:"environment_slug"`
+	OnDestroy       types.String `tfsdk:"on_destroy"`
 	LastUpdated     types.String `tfsdk:"last_updated"`
 	// A pointer is required here for ImportState to work since the initial import will set a nil
 	// value until Read is called.
@@ -528,7 +536,7 @@ func (m *b2bSDKConfigModel) reloadFromSDKConfig(ctx context.Context, c sdk.B2BCo
 		Cookies:    cookies,
 	}
 	m.ID = types.StringValue(
-		fmt.Sprintf("%s.%s", m.ProjectSlug.ValueString(), m.EnvironmentSlug.ValueString()))
+		utils.FormatID(m.ProjectSlug.ValueString(), m.EnvironmentSlug.ValueString()))
 	m.Config = &cfg
 	return diags
 }
@@ -542,19 +550,23 @@ func (r *b2bSDKConfigResource) Configure(
 		return
 	}
 
-	client, ok := req.ProviderData.(*api.API)
+	data, ok := req.ProviderData.(*providerdata.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *api.API (stytch-management-go client), got: %T. Please "+
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please "+
 				"report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.defaults = data.Defaults
+	r.readOnly = data.ReadOnly
+	r.protection = data.Protection
+	r.verticals = data.Verticals
 }
 
 func (r *b2bSDKConfigResource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
@@ -608,6 +620,7 @@ func (r *b2bSDKConfigResource) upgradeB2BSDKConfigStateV0ToV1(
 		ProjectSlug:     types.StringValue(projectSlug),
 		EnvironmentSlug: types.StringValue(environmentSlug),
 		LastUpdated:     types.StringValue(time.Now().Format(time.RFC850)),
+		OnDestroy:       types.StringValue(onDestroyReset),
 	}
 
 	diags = newState.reloadFromSDKConfig(ctx, getResp.Config)
@@ -644,14 +657,18 @@ func (r *b2bSDKConfigResource) Schema(
 				},
 			},
 			"project_slug": schema.StringAttribute{
-				Required:    true,
-				Description: "The slug of the B2B project for which to set the SDK config.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The slug of the B2B project for which to set the SDK config. Defaults to the provider's `default_project_slug`.",
 			},
 			"environment_slug": schema.StringAttribute{
-				Required: true,
+				Optional: true,
+				Computed: true,
 				Description: "The slug of the environment within the B2B project for which to set the " +
-					"SDK config. You may only specify one SDK config per environment.",
+					"SDK config. You may only specify one SDK config per environment. " +
+					"Defaults to the provider's `default_environment_slug`.",
 			},
+			"on_destroy": onDestroyAttribute("B2B SDK config", "disables the SDK"),
 			"last_updated": schema.StringAttribute{
 				Description: "Timestamp of the last Terraform update.",
 				Computed:    true,
@@ -1031,46 +1048,41 @@ func (r *b2bSDKConfigResource) Schema(
 	}
 }
 
-func (r *b2bSDKConfigResource) ValidateConfig(
-	ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse,
-) {
-	var data b2bSDKConfigModel
-	diags := req.Config.Get(ctx, &data)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+// b2bSDKConfigIdentityAttributes are the attributes that identify a B2B SDK config, in the order of
+// its import ID.
+var b2bSDKConfigIdentityAttributes = []string{"project_slug", "environment_slug"}
 
-	// If the project slug is not yet known, skip validation for now. The plugin framework will call
-	// ValidateConfig again when all required values are known.
-	if data.ProjectSlug.IsUnknown() {
-		return
-	}
-
-	ctx = tflog.SetField(ctx, "project_slug", data.ProjectSlug.ValueString())
-	ctx = tflog.SetField(ctx, "environment_slug", data.EnvironmentSlug.ValueString())
-	tflog.Info(ctx, "Validating B2B SDK config")
-
-	getProjectResp, err := r.client.Projects.Get(ctx, projects.GetRequest{
-		ProjectSlug: data.ProjectSlug.ValueString(),
-	})
-	if err != nil {
-		resp.Diagnostics.AddWarning("Failed to get project for vertical check", err.Error())
-		return
-	}
-	if getProjectResp.Project.Vertical != projects.VerticalB2B {
-		resp.Diagnostics.AddError("Invalid project vertical",
-			"The project must be a B2B project for this resource.")
-		return
-	}
+// IdentitySchema defines the identity schema for the resource.
+func (r *b2bSDKConfigResource) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identitySchema(b2bSDKConfigIdentityAttributes...)
+}
 
-	tflog.Info(ctx, "B2B SDK config validated")
+// ModifyPlan plans the provider's default project and environment slugs for the ones omitted
+// from the configuration, checks that the project is a B2B project and guards protected
+// environments.
+func (r *b2bSDKConfigResource) ModifyPlan(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+) {
+	planDefaultProjectSlug(ctx, r.defaults, false, req, resp)
+	planDefaultEnvironmentSlug(ctx, r.defaults, false, req, resp)
+	checkProjectVertical(ctx, r.verticals, "stytch_b2b_sdk_config", projects.VerticalB2B, req, resp)
+	guardProtectedEnvironment(ctx, r.protection, path.Root("environment_slug"), false, req, resp)
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *b2bSDKConfigResource) Create(
 	ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse,
 ) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_b2b_sdk_config", "Create", req.Plan)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, b2bSDKConfigIdentityAttributes...)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "create this resource") {
+		return
+	}
+
 	var plan b2bSDKConfigModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -1082,6 +1094,12 @@ func (r *b2bSDKConfigResource) Create(
 	ctx = tflog.SetField(ctx, "environment_slug", plan.EnvironmentSlug.ValueString())
 	tflog.Info(ctx, "Creating B2B SDK config")
 
+	r.captureOriginalConfig(ctx, plan.ProjectSlug.ValueString(), plan.EnvironmentSlug.ValueString(),
+		resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	cfg, diags := plan.toSDKConfig(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -1114,6 +1132,10 @@ func (r *b2bSDKConfigResource) Create(
 func (r *b2bSDKConfigResource) Read(
 	ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse,
 ) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_b2b_sdk_config", "Read", req.State)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, b2bSDKConfigIdentityAttributes...)
+
 	// Get the current state.
 	var state b2bSDKConfigModel
 	diags := req.State.Get(ctx, &state)
@@ -1131,6 +1153,9 @@ func (r *b2bSDKConfigResource) Read(
 		EnvironmentSlug: state.EnvironmentSlug.ValueString(),
 	})
 	if err != nil {
+		if removeIfNotFound(ctx, err, resp) {
+			return
+		}
 		resp.Diagnostics.AddError("Failed to get B2B SDK config", err.Error())
 		return
 	}
@@ -1139,6 +1164,8 @@ func (r *b2bSDKConfigResource) Read(
 
 	diags = state.reloadFromSDKConfig(ctx, getResp.Config)
 	resp.Diagnostics.Append(diags...)
+	// States written before on_destroy existed have no value for it.
+	state.OnDestroy = defaultOnDestroy(state.OnDestroy)
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -1150,6 +1177,14 @@ func (r *b2bSDKConfigResource) Read(
 func (r *b2bSDKConfigResource) Update(
 	ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse,
 ) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_b2b_sdk_config", "Update", req.Plan)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, b2bSDKConfigIdentityAttributes...)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "update this resource") {
+		return
+	}
+
 	var plan b2bSDKConfigModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -1193,6 +1228,13 @@ func (r *b2bSDKConfigResource) Update(
 func (r *b2bSDKConfigResource) Delete(
 	ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse,
 ) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_b2b_sdk_config", "Delete", req.State)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "delete this resource") {
+		return
+	}
+
 	var state b2bSDKConfigModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -1202,6 +1244,32 @@ func (r *b2bSDKConfigResource) Delete(
 
 	ctx = tflog.SetField(ctx, "project_slug", state.ProjectSlug.ValueString())
 	ctx = tflog.SetField(ctx, "environment_slug", state.EnvironmentSlug.ValueString())
+
+	switch state.OnDestroy.ValueString() {
+	case onDestroyAbandon:
+		tflog.Info(ctx, "Abandoning B2B SDK config")
+		return
+	case onDestroyRestore:
+		var original sdk.B2BConfig
+		if !loadOriginalConfig(ctx, req.Private, &original, &resp.Diagnostics) {
+			return
+		}
+
+		tflog.Info(ctx, "Deleting B2B SDK config (restoring the original config)")
+		_, err := r.client.SDK.SetB2BConfig(ctx, sdk.SetB2BConfigRequest{
+			ProjectSlug:     state.ProjectSlug.ValueString(),
+			EnvironmentSlug: state.EnvironmentSlug.ValueString(),
+			Config:          &original,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to restore B2B SDK config", err.Error())
+			return
+		}
+
+		tflog.Info(ctx, "B2B SDK config restored")
+		return
+	}
+
 	tflog.Info(ctx, "Deleting B2B SDK config")
 
 	// To delete the SDK config, we set the basic config to "disabled". Other fields are left as-is.
@@ -1232,10 +1300,8 @@ func (r *b2bSDKConfigResource) Delete(
 func (r *b2bSDKConfigResource) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
-	parts := strings.Split(req.ID, ".")
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError("Invalid import ID",
-			"The ID must be in the format <project_slug>.<environment_slug>.")
+	parts, ok := importParts(ctx, req, &resp.Diagnostics, b2bSDKConfigIdentityAttributes...)
+	if !ok {
 		return
 	}
 	projectSlug := parts[0]
@@ -1245,7 +1311,25 @@ func (r *b2bSDKConfigResource) ImportState(
 	ctx = tflog.SetField(ctx, "project_slug", projectSlug)
 	ctx = tflog.SetField(ctx, "environment_slug", environmentSlug)
 	tflog.Info(ctx, "Importing B2B SDK config")
-	resp.State.SetAttribute(ctx, path.Root("id"), req.ID)
+	resp.State.SetAttribute(ctx, path.Root("id"), utils.FormatID(parts...))
 	resp.State.SetAttribute(ctx, path.Root("project_slug"), projectSlug)
 	resp.State.SetAttribute(ctx, path.Root("environment_slug"), environmentSlug)
+
+	r.captureOriginalConfig(ctx, projectSlug, environmentSlug, resp.Private, &resp.Diagnostics)
+}
+
+// captureOriginalConfig saves the environment's B2B SDK config before the resource takes it over, so
+// that it can be restored on destroy.
+func (r *b2bSDKConfigResource) captureOriginalConfig(
+	ctx context.Context, projectSlug, environmentSlug string, private privateStateSetter, diags *diag.Diagnostics,
+) {
+	getResp, err := r.client.SDK.GetB2BConfig(ctx, sdk.GetB2BConfigRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: environmentSlug,
+	})
+	if err != nil {
+		diags.AddError("Failed to get original B2B SDK config", err.Error())
+		return
+	}
+	saveOriginalConfig(ctx, private, getResp.Config, diags)
 }
@@ -3,7 +3,6 @@ package resources
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -25,6 +24,8 @@ import (
 	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
 	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
 	"github.com/stytchauth/stytch-management-go/v3/pkg/models/sdk"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/providerdata"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/tracing"
 	"github.com/stytchauth/terraform-provider-stytch/internal/provider/utils"
 )
 
@@ -34,6 +35,8 @@ var (
 	_ resource.ResourceWithConfigure    = &consumerSDKConfigResource{}
 	_ resource.ResourceWithImportState  = &consumerSDKConfigResource{}
 	_ resource.ResourceWithUpgradeState = &consumerSDKConfigResource{}
+	_ resource.ResourceWithModifyPlan   = &consumerSDKConfigResource{}
+	_ resource.ResourceWithIdentity     = &consumerSDKConfigResource{}
 )
 
 func NewConsumerSDKConfigResource() resource.Resource {
@@ -41,13 +44,18 @@ func NewConsumerSDKConfigResource() resource.Resource {
 }
 
 type consumerSDKConfigResource struct {
-	client *api.API
+	client     *api.API
+	defaults   providerdata.Defaults
+	readOnly   bool
+	protection *providerdata.Protection
+	verticals  *providerdata.Verticals
 }
 
 type consumerSDKConfigModel struct {
 	ID              types.String `tfsdk:"id"`
 	ProjectSlug     types.String `tfsdk:"project_slug"`
 	EnvironmentSlug types.String `tfsdk:"environment_slug"`
+	OnDestroy       types.String `tfsdk:"on_destroy"`
 	LastUpdated     types.String `tfsdk:"last_updated"`
 	// A pointer is required here for ImportState to work since the initial import will set a nil
 	// value until Read is called.
@@ -618,7 +626,7 @@ func (m *consumerSDKConfigModel) reloadFromSDKConfig(
 		Cookies:       cookies,
 	}
 	m.ID = types.StringValue(
-		fmt.Sprintf("%s.%s", m.ProjectSlug.ValueString(), m.EnvironmentSlug.ValueString()))
+		utils.FormatID(m.ProjectSlug.ValueString(), m.EnvironmentSlug.ValueString()))
 	m.Config = &cfg
 	return diags
 }
@@ -632,18 +640,22 @@ func (r *consumerSDKConfigResource) Configure(
 		return
 	}
 
-	client, ok := req.ProviderData.(*api.API)
+	data, ok := req.ProviderData.(*providerdata.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *api.API (stytch-management-go client), got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.defaults = data.Defaults
+	r.readOnly = data.ReadOnly
+	r.protection = data.Protection
+	r.verticals = data.Verticals
 }
 
 func (r *consumerSDKConfigResource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
@@ -697,6 +709,7 @@ func (r *consumerSDKConfigResource) upgradeConsumerSDKConfigStateV0ToV1(
 		ProjectSlug:     types.StringValue(projectSlug),
 		EnvironmentSlug: types.StringValue(environmentSlug),
 		LastUpdated:     types.StringValue(time.Now().Format(time.RFC850)),
+		OnDestroy:       types.StringValue(onDestroyReset),
 	}
 
 	diags = newState.reloadFromSDKConfig(ctx, getResp.Config)
@@ -733,14 +746,18 @@ func (r *consumerSDKConfigResource) Schema(
 				},
 			},
 			"project_slug": schema.StringAttribute{
-				Required:    true,
-				Description: "The slug of the consumer project for which to set the SDK config.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The slug of the consumer project for which to set the SDK config. Defaults to the provider's `default_project_slug`.",
 			},
 			"environment_slug": schema.StringAttribute{
-				Required: true,
+				Optional: true,
+				Computed: true,
 				Description: "The slug of the environment for which to set the SDK config. You may only " +
-					"specify one SDK config per environment.",
+					"specify one SDK config per environment. " +
+					"Defaults to the provider's `default_environment_slug`.",
 			},
+			"on_destroy": onDestroyAttribute("Consumer SDK config", "disables the SDK"),
 			"last_updated": schema.StringAttribute{
 				Description: "Timestamp of the last Terraform update.",
 				Computed:    true,
@@ -1177,42 +1194,39 @@ func (r *consumerSDKConfigResource) Schema(
 	}
 }
 
-func (r *consumerSDKConfigResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data consumerSDKConfigModel
-	diags := req.Config.Get(ctx, &data)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+// consumerSDKConfigIdentityAttributes are the attributes that identify a consumer SDK config, in
+// the order of its import ID.
+var consumerSDKConfigIdentityAttributes = []string{"project_slug", "environment_slug"}
 
-	// If the project slug is not yet known, skip validation for now. The plugin framework will call
-	// ValidateConfig again when all required values are known.
-	if data.ProjectSlug.IsUnknown() {
-		return
-	}
-
-	ctx = tflog.SetField(ctx, "project_slug", data.ProjectSlug.ValueString())
-	ctx = tflog.SetField(ctx, "environment_slug", data.EnvironmentSlug.ValueString())
-	tflog.Info(ctx, "Validating Consumer SDK config")
-
-	getProjectResp, err := r.client.Projects.Get(ctx, projects.GetRequest{
-		ProjectSlug: data.ProjectSlug.ValueString(),
-	})
-	if err != nil {
-		resp.Diagnostics.AddWarning("Failed to get project for vertical check", err.Error())
-		return
-	}
-	if getProjectResp.Project.Vertical != projects.VerticalConsumer {
-		resp.Diagnostics.AddError("Invalid project vertical",
-			"The project must be a Consumer project for this resource.")
-		return
-	}
+// IdentitySchema defines the identity schema for the resource.
+func (r *consumerSDKConfigResource) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identitySchema(consumerSDKConfigIdentityAttributes...)
+}
 
-	tflog.Info(ctx, "Validated Consumer SDK config")
+// ModifyPlan plans the provider's default project and environment slugs for the ones omitted
+// from the configuration, checks that the project is a Consumer project and guards protected
+// environments.
+func (r *consumerSDKConfigResource) ModifyPlan(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+) {
+	planDefaultProjectSlug(ctx, r.defaults, false, req, resp)
+	planDefaultEnvironmentSlug(ctx, r.defaults, false, req, resp)
+	checkProjectVertical(ctx, r.verticals, "stytch_consumer_sdk_config", projects.VerticalConsumer, req, resp)
+	guardProtectedEnvironment(ctx, r.protection, path.Root("environment_slug"), false, req, resp)
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *consumerSDKConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_consumer_sdk_config", "Create", req.Plan)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, consumerSDKConfigIdentityAttributes...)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "create this resource") {
+		return
+	}
+
 	var plan consumerSDKConfigModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -1224,6 +1238,12 @@ func (r *consumerSDKConfigResource) Create(ctx context.Context, req resource.Cre
 	ctx = tflog.SetField(ctx, "environment_slug", plan.EnvironmentSlug.ValueString())
 	tflog.Info(ctx, "Creating Consumer SDK config")
 
+	r.captureOriginalConfig(ctx, plan.ProjectSlug.ValueString(), plan.EnvironmentSlug.ValueString(),
+		resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	cfg, diags := plan.toSDKConfig(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -1254,6 +1274,10 @@ func (r *consumerSDKConfigResource) Create(ctx context.Context, req resource.Cre
 
 // Read refreshes the Terraform state with the latest data.
 func (r *consumerSDKConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_consumer_sdk_config", "Read", req.State)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, consumerSDKConfigIdentityAttributes...)
+
 	// Get the current state
 	var state consumerSDKConfigModel
 	diags := req.State.Get(ctx, &state)
@@ -1271,6 +1295,9 @@ func (r *consumerSDKConfigResource) Read(ctx context.Context, req resource.ReadR
 		EnvironmentSlug: state.EnvironmentSlug.ValueString(),
 	})
 	if err != nil {
+		if removeIfNotFound(ctx, err, resp) {
+			return
+		}
 		resp.Diagnostics.AddError("Failed to get Consumer SDK config", err.Error())
 		return
 	}
@@ -1279,6 +1306,8 @@ func (r *consumerSDKConfigResource) Read(ctx context.Context, req resource.ReadR
 
 	diags = state.reloadFromSDKConfig(ctx, getResp.Config)
 	resp.Diagnostics.Append(diags...)
+	// States written before on_destroy existed have no value for it.
+	state.OnDestroy = defaultOnDestroy(state.OnDestroy)
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -1288,6 +1317,14 @@ func (r *consumerSDKConfigResource) Read(ctx context.Context, req resource.ReadR
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *consumerSDKConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_consumer_sdk_config", "Update", req.Plan)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, consumerSDKConfigIdentityAttributes...)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "update this resource") {
+		return
+	}
+
 	var plan consumerSDKConfigModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -1329,6 +1366,13 @@ func (r *consumerSDKConfigResource) Update(ctx context.Context, req resource.Upd
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *consumerSDKConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_consumer_sdk_config", "Delete", req.State)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "delete this resource") {
+		return
+	}
+
 	var state consumerSDKConfigModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -1338,6 +1382,32 @@ func (r *consumerSDKConfigResource) Delete(ctx context.Context, req resource.Del
 
 	ctx = tflog.SetField(ctx, "project_slug", state.ProjectSlug.ValueString())
 	ctx = tflog.SetField(ctx, "environment_slug", state.EnvironmentSlug.ValueString())
+
+	switch state.OnDestroy.ValueString() {
+	case onDestroyAbandon:
+		tflog.Info(ctx, "Abandoning Consumer SDK config")
+		return
+	case onDestroyRestore:
+		var original sdk.ConsumerConfig
+		if !loadOriginalConfig(ctx, req.Private, &original, &resp.Diagnostics) {
+			return
+		}
+
+		tflog.Info(ctx, "Deleting Consumer SDK config (restoring the original config)")
+		_, err := r.client.SDK.SetConsumerConfig(ctx, sdk.SetConsumerConfigRequest{
+			ProjectSlug:     state.ProjectSlug.ValueString(),
+			EnvironmentSlug: state.EnvironmentSlug.ValueString(),
+			Config:          &original,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to restore Consumer SDK config", err.Error())
+			return
+		}
+
+		tflog.Info(ctx, "Consumer SDK config restored")
+		return
+	}
+
 	tflog.Info(ctx, "Deleting Consumer SDK config")
 
 	// To delete the SDK config, we set the basic config to "disabled". Other fields are left as-is.
@@ -1366,10 +1436,8 @@ func (r *consumerSDKConfigResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *consumerSDKConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.Split(req.ID, ".")
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError("Invalid import ID",
-			"The ID must be in the format <project_slug>.<environment_slug>.")
+	parts, ok := importParts(ctx, req, &resp.Diagnostics, consumerSDKConfigIdentityAttributes...)
+	if !ok {
 		return
 	}
 	projectSlug := parts[0]
@@ -1379,7 +1447,25 @@ func (r *consumerSDKConfigResource) ImportState(ctx context.Context, req resourc
 	ctx = tflog.SetField(ctx, "project_slug", projectSlug)
 	ctx = tflog.SetField(ctx, "environment_slug", environmentSlug)
 	tflog.Info(ctx, "Importing Consumer SDK config")
-	resp.State.SetAttribute(ctx, path.Root("id"), req.ID)
+	resp.State.SetAttribute(ctx, path.Root("id"), utils.FormatID(parts...))
 	resp.State.SetAttribute(ctx, path.Root("project_slug"), projectSlug)
 	resp.State.SetAttribute(ctx, path.Root("environment_slug"), environmentSlug)
+
+	r.captureOriginalConfig(ctx, projectSlug, environmentSlug, resp.Private, &resp.Diagnostics)
+}
+
+// captureOriginalConfig saves the environment's Consumer SDK config before the resource takes it over, so
+// that it can be restored on destroy.
+func (r *consumerSDKConfigResource) captureOriginalConfig(
+	ctx context.Context, projectSlug, environmentSlug string, private privateStateSetter, diags *diag.Diagnostics,
+) {
+	getResp, err := r.client.SDK.GetConsumerConfig(ctx, sdk.GetConsumerConfigRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: environmentSlug,
+	})
+	if err != nil {
+		diags.AddError("Failed to get original Consumer SDK config", err.Error())
+		return
+	}
+	saveOriginalConfig(ctx, private, getResp.Config, diags)
 }
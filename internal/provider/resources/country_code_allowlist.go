@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
 	"github.com/stytchauth/stytch-management-go/v3/pkg/models/countrycodeallowlist"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/providerdata"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/tracing"
 	"github.com/stytchauth/terraform-provider-stytch/internal/provider/utils"
 )
 
@@ -27,6 +30,8 @@ var (
 	_ resource.ResourceWithConfigure    = &countryCodeAllowlistResource{}
 	_ resource.ResourceWithImportState  = &countryCodeAllowlistResource{}
 	_ resource.ResourceWithUpgradeState = &countryCodeAllowlistResource{}
+	_ resource.ResourceWithModifyPlan   = &countryCodeAllowlistResource{}
+	_ resource.ResourceWithIdentity     = &countryCodeAllowlistResource{}
 )
 
 func NewCountryCodeAllowlistResource() resource.Resource {
@@ -50,7 +55,10 @@ func DeliveryMethods() []DeliveryMethod {
 }
 
 type countryCodeAllowlistResource struct {
-	client *api.API
+	client     *api.API
+	defaults   providerdata.Defaults
+	readOnly   bool
+	protection *providerdata.Protection
 }
 
 type countryCodeAllowlistModel struct {
@@ -59,6 +67,7 @@ type countryCodeAllowlistModel struct {
 	EnvironmentSlug types.String `tfsdk:"environment_slug"`
 	DeliveryMethod  types.String `tfsdk:"delivery_method"`
 	CountryCodes    types.Set    `tfsdk:"country_codes"`
+	OnDestroy       types.String `tfsdk:"on_destroy"`
 	LastUpdated     types.String `tfsdk:"last_updated"`
 }
 
@@ -110,18 +119,21 @@ func (r *countryCodeAllowlistResource) Configure(
 		return
 	}
 
-	client, ok := req.ProviderData.(*api.API)
+	data, ok := req.ProviderData.(*providerdata.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *api.API (stytch-management-go client), got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.defaults = data.Defaults
+	r.readOnly = data.ReadOnly
+	r.protection = data.Protection
 }
 
 func (r *countryCodeAllowlistResource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
@@ -192,11 +204,12 @@ func (r *countryCodeAllowlistResource) upgradeCountryCodeAllowlistStateV0ToV1(
 	}
 
 	newState := countryCodeAllowlistModel{
-		ID:              types.StringValue(fmt.Sprintf("%s.%s.%s", projectSlug, environmentSlug, deliveryMethod)),
+		ID:              types.StringValue(utils.FormatID(projectSlug, environmentSlug, string(deliveryMethod))),
 		ProjectSlug:     types.StringValue(projectSlug),
 		EnvironmentSlug: types.StringValue(environmentSlug),
 		DeliveryMethod:  types.StringValue(string(deliveryMethod)),
 		LastUpdated:     types.StringValue(time.Now().Format(time.RFC850)),
+		OnDestroy:       types.StringValue(onDestroyReset),
 	}
 
 	newState.CountryCodes, diags = types.SetValueFrom(ctx, types.StringType, countryCodes)
@@ -232,17 +245,19 @@ func (r *countryCodeAllowlistResource) Schema(
 				},
 			},
 			"project_slug": schema.StringAttribute{
-				Required:    true,
-				Description: "The slug of the project to which the country code allowlist belongs.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The slug of the project to which the country code allowlist belongs. Defaults to the provider's `default_project_slug`.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"environment_slug": schema.StringAttribute{
-				Required:    true,
-				Description: "The slug of the environment to which the country code allowlist belongs.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The slug of the environment to which the country code allowlist belongs. Defaults to the provider's `default_environment_slug`.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"delivery_method": schema.StringAttribute{
@@ -260,6 +275,7 @@ func (r *countryCodeAllowlistResource) Schema(
 				Required:    true,
 				ElementType: types.StringType,
 			},
+			"on_destroy": onDestroyAttribute("country code allowlist", "resets it to the default country codes"),
 			"last_updated": schema.StringAttribute{
 				Description: "Timestamp of the last Terraform update.",
 				Computed:    true,
@@ -268,6 +284,50 @@ func (r *countryCodeAllowlistResource) Schema(
 	}
 }
 
+// countryCodeAllowlistIdentityAttributes are the attributes that identify a country code allowlist,
+// in the order of its import ID.
+var countryCodeAllowlistIdentityAttributes = []string{"project_slug", "environment_slug", "delivery_method"}
+
+// IdentitySchema defines the identity schema for the resource.
+func (r *countryCodeAllowlistResource) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identitySchema(countryCodeAllowlistIdentityAttributes...)
+}
+
+// captureOriginalConfig saves the environment's country code allowlist before the resource takes
+// it over, so that it can be restored on destroy.
+func (r *countryCodeAllowlistResource) captureOriginalConfig(
+	ctx context.Context, projectSlug, environmentSlug, deliveryMethod string, private privateStateSetter,
+	diags *diag.Diagnostics,
+) {
+	var countryCodes []string
+	if deliveryMethod == string(DeliveryMethodSMS) {
+		getResp, err := r.client.CountryCodeAllowlist.GetAllowedSMSCountryCodes(ctx,
+			countrycodeallowlist.GetAllowedSMSCountryCodesRequest{
+				ProjectSlug:     projectSlug,
+				EnvironmentSlug: environmentSlug,
+			})
+		if err != nil {
+			diags.AddError("Failed to get original SMS country code allowlist", err.Error())
+			return
+		}
+		countryCodes = getResp.CountryCodes
+	} else {
+		getResp, err := r.client.CountryCodeAllowlist.GetAllowedWhatsAppCountryCodes(ctx,
+			countrycodeallowlist.GetAllowedWhatsAppCountryCodesRequest{
+				ProjectSlug:     projectSlug,
+				EnvironmentSlug: environmentSlug,
+			})
+		if err != nil {
+			diags.AddError("Failed to get original WhatsApp country code allowlist", err.Error())
+			return
+		}
+		countryCodes = getResp.CountryCodes
+	}
+	saveOriginalConfig(ctx, private, countryCodes, diags)
+}
+
 func (r *countryCodeAllowlistResource) setCountryCodeAllowlist(
 	ctx context.Context, plan countryCodeAllowlistModel, countryCodes []string,
 ) error {
@@ -290,10 +350,29 @@ func (r *countryCodeAllowlistResource) setCountryCodeAllowlist(
 	}
 }
 
+// ModifyPlan plans the provider's default project and environment slugs for the ones omitted
+// from the configuration and guards protected environments.
+func (r *countryCodeAllowlistResource) ModifyPlan(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+) {
+	planDefaultProjectSlug(ctx, r.defaults, true, req, resp)
+	planDefaultEnvironmentSlug(ctx, r.defaults, true, req, resp)
+	guardProtectedEnvironment(ctx, r.protection, path.Root("environment_slug"), false, req, resp,
+		path.Root("project_slug"), path.Root("environment_slug"), path.Root("delivery_method"))
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *countryCodeAllowlistResource) Create(
 	ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse,
 ) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_country_code_allowlist", "Create", req.Plan)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, countryCodeAllowlistIdentityAttributes...)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "create this resource") {
+		return
+	}
+
 	// Get the plan from the request.
 	var plan countryCodeAllowlistModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -306,6 +385,15 @@ func (r *countryCodeAllowlistResource) Create(
 	ctx = tflog.SetField(ctx, "environment_slug", plan.EnvironmentSlug.ValueString())
 	tflog.Info(ctx, "Creating country code allowlist")
 
+	unlock := utils.WriteLocks.Lock(utils.EnvironmentLockKey(utils.LockFamilyCountryCodeAllowlist, plan.ProjectSlug.ValueString(), plan.EnvironmentSlug.ValueString()))
+	defer unlock()
+
+	r.captureOriginalConfig(ctx, plan.ProjectSlug.ValueString(), plan.EnvironmentSlug.ValueString(),
+		plan.DeliveryMethod.ValueString(), resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Load the plan's list of country codes into an array.
 	countryCodes := make([]string, 0, len(plan.CountryCodes.Elements()))
 	diags = plan.CountryCodes.ElementsAs(ctx, &countryCodes, false)
@@ -323,7 +411,7 @@ func (r *countryCodeAllowlistResource) Create(
 	tflog.Info(ctx, "Country code allowlist created")
 
 	// Update the plan and set the state.
-	plan.ID = types.StringValue(plan.ProjectSlug.ValueString() + "." + plan.EnvironmentSlug.ValueString() + "." + plan.DeliveryMethod.ValueString())
+	plan.ID = types.StringValue(utils.FormatID(plan.ProjectSlug.ValueString(), plan.EnvironmentSlug.ValueString(), plan.DeliveryMethod.ValueString()))
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -331,6 +419,10 @@ func (r *countryCodeAllowlistResource) Create(
 
 // Read refreshes the Terraform state with the latest data.
 func (r *countryCodeAllowlistResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_country_code_allowlist", "Read", req.State)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, countryCodeAllowlistIdentityAttributes...)
+
 	// Get the current state.
 	var state countryCodeAllowlistModel
 	diags := req.State.Get(ctx, &state)
@@ -352,6 +444,9 @@ func (r *countryCodeAllowlistResource) Read(ctx context.Context, req resource.Re
 				EnvironmentSlug: state.EnvironmentSlug.ValueString(),
 			})
 		if err != nil {
+			if removeIfNotFound(ctx, err, resp) {
+				return
+			}
 			resp.Diagnostics.AddError("Failed to read SMS country code allowlist", err.Error())
 			return
 		}
@@ -363,6 +458,9 @@ func (r *countryCodeAllowlistResource) Read(ctx context.Context, req resource.Re
 				EnvironmentSlug: state.EnvironmentSlug.ValueString(),
 			})
 		if err != nil {
+			if removeIfNotFound(ctx, err, resp) {
+				return
+			}
 			resp.Diagnostics.AddError("Failed to read WhatsApp country code allowlist", err.Error())
 			return
 		}
@@ -378,6 +476,8 @@ func (r *countryCodeAllowlistResource) Read(ctx context.Context, req resource.Re
 	}
 
 	// Update the state.
+	// States written before on_destroy existed have no value for it.
+	state.OnDestroy = defaultOnDestroy(state.OnDestroy)
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 }
@@ -385,6 +485,14 @@ func (r *countryCodeAllowlistResource) Read(ctx context.Context, req resource.Re
 func (r *countryCodeAllowlistResource) Update(
 	ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse,
 ) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_country_code_allowlist", "Update", req.Plan)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, countryCodeAllowlistIdentityAttributes...)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "update this resource") {
+		return
+	}
+
 	// Get the plan from the request.
 	var plan countryCodeAllowlistModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -397,6 +505,9 @@ func (r *countryCodeAllowlistResource) Update(
 	ctx = tflog.SetField(ctx, "environment_slug", plan.EnvironmentSlug.ValueString())
 	tflog.Info(ctx, "Updating country code allowlist")
 
+	unlock := utils.WriteLocks.Lock(utils.EnvironmentLockKey(utils.LockFamilyCountryCodeAllowlist, plan.ProjectSlug.ValueString(), plan.EnvironmentSlug.ValueString()))
+	defer unlock()
+
 	// Load the plan's list of country codes into an array.
 	countryCodes := make([]string, 0, len(plan.CountryCodes.Elements()))
 	diags = plan.CountryCodes.ElementsAs(ctx, &countryCodes, false)
@@ -423,6 +534,13 @@ func (r *countryCodeAllowlistResource) Update(
 func (r *countryCodeAllowlistResource) Delete(
 	ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse,
 ) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_country_code_allowlist", "Delete", req.State)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "delete this resource") {
+		return
+	}
+
 	// Get the current state.
 	var state countryCodeAllowlistModel
 	diags := req.State.Get(ctx, &state)
@@ -435,6 +553,29 @@ func (r *countryCodeAllowlistResource) Delete(
 	ctx = tflog.SetField(ctx, "environment_slug", state.EnvironmentSlug.ValueString())
 	tflog.Info(ctx, "Setting country code allowlist to default value")
 
+	unlock := utils.WriteLocks.Lock(utils.EnvironmentLockKey(utils.LockFamilyCountryCodeAllowlist, state.ProjectSlug.ValueString(), state.EnvironmentSlug.ValueString()))
+	defer unlock()
+
+	switch state.OnDestroy.ValueString() {
+	case onDestroyAbandon:
+		tflog.Info(ctx, "Abandoning country code allowlist")
+		return
+	case onDestroyRestore:
+		var original []string
+		if !loadOriginalConfig(ctx, req.Private, &original, &resp.Diagnostics) {
+			return
+		}
+
+		// Restore the country codes the environment allowed before the resource was created.
+		err := r.setCountryCodeAllowlist(ctx, state, original)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to restore country code allowlist", err.Error())
+			return
+		}
+		tflog.Info(ctx, "Restored country code allowlist to its original state")
+		return
+	}
+
 	// Reset the country code allowlist to the default allowed country codes.
 	err := r.setCountryCodeAllowlist(ctx, state, DefaultCountryCodes)
 	if err != nil {
@@ -449,9 +590,8 @@ func (r *countryCodeAllowlistResource) Delete(
 func (r *countryCodeAllowlistResource) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
-	parts := strings.Split(req.ID, ".")
-	if len(parts) != 3 {
-		resp.Diagnostics.AddError("Invalid import ID", "The ID must be in the format <project_slug>.<environment_slug>.<delivery_method>")
+	parts, ok := importParts(ctx, req, &resp.Diagnostics, countryCodeAllowlistIdentityAttributes...)
+	if !ok {
 		return
 	}
 
@@ -460,8 +600,10 @@ func (r *countryCodeAllowlistResource) ImportState(
 	ctx = tflog.SetField(ctx, "environment_slug", parts[1])
 	ctx = tflog.SetField(ctx, "delivery_method", parts[2])
 	tflog.Info(ctx, "Importing country code allowlist")
-	resp.State.SetAttribute(ctx, path.Root("id"), req.ID)
+	resp.State.SetAttribute(ctx, path.Root("id"), utils.FormatID(parts...))
 	resp.State.SetAttribute(ctx, path.Root("project_slug"), parts[0])
 	resp.State.SetAttribute(ctx, path.Root("environment_slug"), parts[1])
 	resp.State.SetAttribute(ctx, path.Root("delivery_method"), parts[2])
+
+	r.captureOriginalConfig(ctx, parts[0], parts[1], parts[2], resp.Private, &resp.Diagnostics)
 }
@@ -0,0 +1,188 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// What a singleton configuration resource does with the configuration an environment already has
+// when it is created.
+const (
+	// createModeOverwrite replaces the configuration, which is what creating these resources has
+	// always done.
+	createModeOverwrite = "overwrite"
+	// createModeRequireDefault fails unless the configuration still has its default values.
+	createModeRequireDefault = "require_default"
+	// createModeAdopt takes the configuration over without changing it.
+	createModeAdopt = "adopt"
+)
+
+// createModeIgnoredAttributes are the attributes of singleton configuration resources that don't
+// hold configuration values, and so are never compared with the environment's configuration.
+var createModeIgnoredAttributes = map[string]bool{
+	"id":               true,
+	"project_slug":     true,
+	"environment_slug": true,
+	"template_type":    true,
+	"create_mode":      true,
+	"on_destroy":       true,
+	"last_updated":     true,
+}
+
+// createModeAttribute returns the schema of the create_mode attribute of a singleton configuration
+// resource. what names the configuration, such as "password config".
+func createModeAttribute(what string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString(createModeOverwrite),
+		Description: fmt.Sprintf("What to do with the %s the environment already has when this resource is created: "+
+			"`overwrite` replaces it with the configured values, `require_default` fails unless it still has its "+
+			"default values and `adopt` takes it over without changing it, using its current values for the "+
+			"attributes omitted from the configuration and failing if a configured value differs from the current "+
+			"one. Defaults to `overwrite`.", what),
+		Validators: []validator.String{
+			stringvalidator.OneOf(createModeOverwrite, createModeRequireDefault, createModeAdopt),
+		},
+	}
+}
+
+// defaultCreateMode returns createMode, or createModeOverwrite for states written before the
+// create_mode attribute existed.
+func defaultCreateMode(createMode types.String) types.String {
+	if createMode.IsNull() || createMode.IsUnknown() {
+		return types.StringValue(createModeOverwrite)
+	}
+	return createMode
+}
+
+// planCreateModeDefaults plans the value in defaults of each attribute omitted from the
+// configuration, unless create_mode is createModeAdopt, where the attributes keep the values adopted
+// from the environment instead. These attributes can't have a schema Default, which the framework
+// would plan for them on every plan and so overwrite the adopted values.
+func planCreateModeDefaults(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+	defaults map[string]attr.Value,
+) {
+	// Nothing to plan on destroy.
+	if resp.Diagnostics.HasError() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var createMode types.String
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("create_mode"), &createMode)...)
+	if resp.Diagnostics.HasError() || createMode.ValueString() == createModeAdopt {
+		return
+	}
+
+	for name, value := range defaults {
+		var configValue attr.Value
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &configValue)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if configValue.IsNull() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(name), value)...)
+		}
+	}
+}
+
+// attributeDifference is an attribute whose value differs between two models.
+type attributeDifference struct {
+	Name     string
+	Value    attr.Value
+	Expected attr.Value
+}
+
+// compareModels returns the configuration attributes whose values differ between model and
+// expected, which are models of the same resource.
+func compareModels(model, expected interface{}) []attributeDifference {
+	modelValue := reflect.ValueOf(model)
+	expectedValue := reflect.ValueOf(expected)
+
+	var differences []attributeDifference
+	for i := 0; i < modelValue.NumField(); i++ {
+		name := modelValue.Type().Field(i).Tag.Get("tfsdk")
+		if createModeIgnoredAttributes[name] {
+			continue
+		}
+		value := modelValue.Field(i).Interface().(attr.Value)
+		expected := expectedValue.Field(i).Interface().(attr.Value)
+		if !value.Equal(expected) {
+			differences = append(differences, attributeDifference{Name: name, Value: value, Expected: expected})
+		}
+	}
+	return differences
+}
+
+// adoptModel plans the existing values of the configuration attributes omitted from config, which
+// plan points to the planned model of, for createModeAdopt. It returns the configured attributes
+// whose existing values differ from the configured ones, which can't be adopted.
+func adoptModel(plan, config, existing interface{}) []attributeDifference {
+	planValue := reflect.ValueOf(plan).Elem()
+	configValue := reflect.ValueOf(config)
+	existingValue := reflect.ValueOf(existing)
+
+	var conflicts []attributeDifference
+	for i := 0; i < planValue.NumField(); i++ {
+		name := planValue.Type().Field(i).Tag.Get("tfsdk")
+		if createModeIgnoredAttributes[name] {
+			continue
+		}
+		planned := planValue.Field(i).Interface().(attr.Value)
+		value := existingValue.Field(i).Interface().(attr.Value)
+		// Only computed attributes have a planned value when they are omitted from the
+		// configuration, and only those can take the existing value.
+		if configValue.Field(i).Interface().(attr.Value).IsNull() && !planned.IsNull() {
+			planValue.Field(i).Set(existingValue.Field(i))
+			continue
+		}
+		if !planned.Equal(value) {
+			conflicts = append(conflicts, attributeDifference{Name: name, Value: value, Expected: planned})
+		}
+	}
+	return conflicts
+}
+
+// addNonDefaultError fails the creation of a resource with createModeRequireDefault because the
+// environment's configuration, which what names, has non-default values.
+func addNonDefaultError(diags *diag.Diagnostics, what string, differences []attributeDifference) {
+	lines := make([]string, 0, len(differences))
+	for _, difference := range differences {
+		lines = append(lines, fmt.Sprintf("  %s = %s (default: %s)", difference.Name, difference.Value, difference.Expected))
+	}
+	diags.AddError(
+		fmt.Sprintf("The %s has non-default values", what),
+		fmt.Sprintf("create_mode is %q, but the environment's %s has been changed from its defaults:\n\n%s\n\n"+
+			"Import the resource, or set create_mode to %q to take over its current values or to %q to replace them.",
+			createModeRequireDefault, what, strings.Join(lines, "\n"), createModeAdopt, createModeOverwrite),
+	)
+}
+
+// addAdoptConflictError fails the creation of a resource with createModeAdopt because configured
+// values differ from the environment's configuration, which what names.
+func addAdoptConflictError(diags *diag.Diagnostics, what string, conflicts []attributeDifference) {
+	lines := make([]string, 0, len(conflicts))
+	for _, conflict := range conflicts {
+		lines = append(lines, fmt.Sprintf("  %s = %s (configured: %s)", conflict.Name, conflict.Value, conflict.Expected))
+	}
+	diags.AddError(
+		fmt.Sprintf("The %s can't be adopted", what),
+		fmt.Sprintf("create_mode is %q, but the environment's %s differs from the configuration:\n\n%s\n\n"+
+			"Configure the current values, or omit them to adopt them, and change them once the resource is created, "+
+			"or set create_mode to %q to replace them.",
+			createModeAdopt, what, strings.Join(lines, "\n"), createModeOverwrite),
+	)
+}
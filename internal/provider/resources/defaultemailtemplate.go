@@ -16,6 +16,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
 	"github.com/stytchauth/stytch-management-go/v3/pkg/models/emailtemplates"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/providerdata"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/tracing"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/utils"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -23,6 +26,8 @@ var (
 	_ resource.Resource                = &defaultEmailTemplateResource{}
 	_ resource.ResourceWithConfigure   = &defaultEmailTemplateResource{}
 	_ resource.ResourceWithImportState = &defaultEmailTemplateResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultEmailTemplateResource{}
+	_ resource.ResourceWithIdentity    = &defaultEmailTemplateResource{}
 )
 
 // NewDefaultEmailTemplateResource is a helper function to simplify the provider implementation.
@@ -31,7 +36,9 @@ func NewDefaultEmailTemplateResource() resource.Resource {
 }
 
 type defaultEmailTemplateResource struct {
-	client *api.API
+	client   *api.API
+	defaults providerdata.Defaults
+	readOnly bool
 }
 
 type defaultEmailTemplateModel struct {
@@ -51,16 +58,18 @@ func (r *defaultEmailTemplateResource) Configure(_ context.Context, req resource
 		return
 	}
 
-	client, ok := req.ProviderData.(*api.API)
+	data, ok := req.ProviderData.(*providerdata.ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *api.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.defaults = data.Defaults
+	r.readOnly = data.ReadOnly
 }
 
 func (r *defaultEmailTemplateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -75,10 +84,11 @@ func (r *defaultEmailTemplateResource) Schema(_ context.Context, _ resource.Sche
 				},
 			},
 			"project_slug": schema.StringAttribute{
-				Required:    true,
-				Description: "The slug of the project for which to set the default email template",
+				Optional:    true,
+				Computed:    true,
+				Description: "The slug of the project for which to set the default email template. Defaults to the provider's `default_project_slug`.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"last_updated": schema.StringAttribute{
@@ -103,8 +113,34 @@ func (r *defaultEmailTemplateResource) Schema(_ context.Context, _ resource.Sche
 	}
 }
 
+// defaultEmailTemplateIdentityAttributes are the attributes that identify a default email template,
+// in the order of its import ID.
+var defaultEmailTemplateIdentityAttributes = []string{"project_slug", "email_template_type"}
+
+// IdentitySchema defines the identity schema for the resource.
+func (r *defaultEmailTemplateResource) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identitySchema(defaultEmailTemplateIdentityAttributes...)
+}
+
+// ModifyPlan plans the provider's default project slug if it is omitted from the configuration.
+func (r *defaultEmailTemplateResource) ModifyPlan(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+) {
+	planDefaultProjectSlug(ctx, r.defaults, true, req, resp)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *defaultEmailTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_default_email_template", "Create", req.Plan)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, defaultEmailTemplateIdentityAttributes...)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "create this resource") {
+		return
+	}
+
 	var plan defaultEmailTemplateModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -116,6 +152,9 @@ func (r *defaultEmailTemplateResource) Create(ctx context.Context, req resource.
 	ctx = tflog.SetField(ctx, "email_template_type", plan.EmailTemplateType.ValueString())
 	tflog.Info(ctx, "Setting default email template")
 
+	unlock := utils.WriteLocks.Lock(utils.ProjectLockKey(utils.LockFamilyEmailTemplates, plan.ProjectSlug.ValueString()))
+	defer unlock()
+
 	_, err := r.client.EmailTemplates.SetDefault(ctx, emailtemplates.SetDefaultRequest{
 		ProjectSlug:       plan.ProjectSlug.ValueString(),
 		EmailTemplateType: emailtemplates.TemplateType(plan.EmailTemplateType.ValueString()),
@@ -128,7 +167,7 @@ func (r *defaultEmailTemplateResource) Create(ctx context.Context, req resource.
 
 	tflog.Info(ctx, "Set default email template")
 
-	plan.ID = types.StringValue(fmt.Sprintf("%s.%s", plan.ProjectSlug.ValueString(), plan.EmailTemplateType.ValueString()))
+	plan.ID = types.StringValue(utils.FormatID(plan.ProjectSlug.ValueString(), plan.EmailTemplateType.ValueString()))
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -136,6 +175,10 @@ func (r *defaultEmailTemplateResource) Create(ctx context.Context, req resource.
 
 // Read refreshes the Terraform state with the latest data.
 func (r *defaultEmailTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_default_email_template", "Read", req.State)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, defaultEmailTemplateIdentityAttributes...)
+
 	var state defaultEmailTemplateModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -152,6 +195,9 @@ func (r *defaultEmailTemplateResource) Read(ctx context.Context, req resource.Re
 		EmailTemplateType: emailtemplates.TemplateType(state.EmailTemplateType.ValueString()),
 	})
 	if err != nil {
+		if removeIfNotFound(ctx, err, resp) {
+			return
+		}
 		resp.Diagnostics.AddError("Failed to get default email template", err.Error())
 		return
 	}
@@ -165,6 +211,14 @@ func (r *defaultEmailTemplateResource) Read(ctx context.Context, req resource.Re
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *defaultEmailTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_default_email_template", "Update", req.Plan)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, defaultEmailTemplateIdentityAttributes...)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "update this resource") {
+		return
+	}
+
 	var plan defaultEmailTemplateModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -176,6 +230,9 @@ func (r *defaultEmailTemplateResource) Update(ctx context.Context, req resource.
 	ctx = tflog.SetField(ctx, "email_template_type", plan.EmailTemplateType.ValueString())
 	tflog.Info(ctx, "Updating default email template")
 
+	unlock := utils.WriteLocks.Lock(utils.ProjectLockKey(utils.LockFamilyEmailTemplates, plan.ProjectSlug.ValueString()))
+	defer unlock()
+
 	_, err := r.client.EmailTemplates.SetDefault(ctx, emailtemplates.SetDefaultRequest{
 		ProjectSlug:       plan.ProjectSlug.ValueString(),
 		EmailTemplateType: emailtemplates.TemplateType(plan.EmailTemplateType.ValueString()),
@@ -195,6 +252,13 @@ func (r *defaultEmailTemplateResource) Update(ctx context.Context, req resource.
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *defaultEmailTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_default_email_template", "Delete", req.State)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "delete this resource") {
+		return
+	}
+
 	var state defaultEmailTemplateModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -206,6 +270,9 @@ func (r *defaultEmailTemplateResource) Delete(ctx context.Context, req resource.
 	ctx = tflog.SetField(ctx, "email_template_type", state.EmailTemplateType.ValueString())
 	tflog.Info(ctx, "Unsetting default email template")
 
+	unlock := utils.WriteLocks.Lock(utils.ProjectLockKey(utils.LockFamilyEmailTemplates, state.ProjectSlug.ValueString()))
+	defer unlock()
+
 	_, err := r.client.EmailTemplates.UnsetDefault(ctx, emailtemplates.UnsetDefaultRequest{
 		ProjectSlug:       state.ProjectSlug.ValueString(),
 		EmailTemplateType: emailtemplates.TemplateType(state.EmailTemplateType.ValueString()),
@@ -220,30 +287,12 @@ func (r *defaultEmailTemplateResource) Delete(ctx context.Context, req resource.
 
 func (r *defaultEmailTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Import ID format: project_slug.email_template_type
-	idParts := types.StringValue(req.ID).ValueString()
-	parts := types.StringValue(req.ID).ValueString()
-
-	// Split by "."
-	dotIndex := len(parts) - 1
-	for i := len(parts) - 1; i >= 0; i-- {
-		if parts[i] == '.' {
-			dotIndex = i
-			break
-		}
-	}
-
-	if dotIndex == -1 || dotIndex == 0 || dotIndex == len(parts)-1 {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Import ID must be in format 'project_slug.email_template_type', got: %s", req.ID),
-		)
+	parts, ok := importParts(ctx, req, &resp.Diagnostics, defaultEmailTemplateIdentityAttributes...)
+	if !ok {
 		return
 	}
 
-	projectSlug := parts[:dotIndex]
-	emailTemplateType := parts[dotIndex+1:]
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_slug"), projectSlug)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email_template_type"), emailTemplateType)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), utils.FormatID(parts...))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_slug"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email_template_type"), parts[1])...)
 }
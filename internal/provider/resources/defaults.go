@@ -0,0 +1,66 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/providerdata"
+)
+
+// planDefaultProjectSlug plans the provider's default project slug when project_slug is omitted
+// from the configuration.
+func planDefaultProjectSlug(
+	ctx context.Context, defaults providerdata.Defaults, requiresReplace bool,
+	req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+) {
+	planProviderDefault(ctx, path.Root("project_slug"), defaults.ProjectSlug,
+		providerdata.DefaultProjectSlugAttribute, requiresReplace, req, resp)
+}
+
+// planDefaultEnvironmentSlug plans the provider's default environment slug when environment_slug is
+// omitted from the configuration.
+func planDefaultEnvironmentSlug(
+	ctx context.Context, defaults providerdata.Defaults, requiresReplace bool,
+	req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+) {
+	planProviderDefault(ctx, path.Root("environment_slug"), defaults.EnvironmentSlug,
+		providerdata.DefaultEnvironmentSlugAttribute, requiresReplace, req, resp)
+}
+
+// planProviderDefault sets the planned value of an optional and computed string attribute to the
+// provider-level default when the attribute is omitted from the configuration. If requiresReplace
+// is set, a default that differs from the value in state replaces the resource, the same as
+// changing the attribute in the configuration would through RequiresReplaceIfConfigured.
+func planProviderDefault(
+	ctx context.Context, attrPath path.Path, defaultValue, providerAttribute string, requiresReplace bool,
+	req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+) {
+	// Nothing to do on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var configValue types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, attrPath, &configValue)...)
+	if resp.Diagnostics.HasError() || !configValue.IsNull() {
+		return
+	}
+
+	if defaultValue == "" {
+		providerdata.AddMissingDefaultError(&resp.Diagnostics, attrPath, providerAttribute)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, attrPath, defaultValue)...)
+
+	if !requiresReplace || req.State.Raw.IsNull() {
+		return
+	}
+	var stateValue types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, attrPath, &stateValue)...)
+	if !stateValue.IsNull() && stateValue.ValueString() != defaultValue {
+		resp.RequiresReplace = append(resp.RequiresReplace, attrPath)
+	}
+}
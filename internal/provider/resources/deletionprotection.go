@@ -0,0 +1,54 @@
+package resources
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The values of deletion_protection when it is omitted from the configuration.
+const (
+	projectDeletionProtectionDefault     = true
+	environmentDeletionProtectionDefault = false
+)
+
+// deletionProtectionAttribute returns the schema of the deletion_protection attribute of a
+// resource whose deletion can't be undone. what names the resource, such as "project", and
+// defaultValue is the value of the attribute when it is omitted from the configuration.
+func deletionProtectionAttribute(what string, defaultValue bool) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(defaultValue),
+		Description: fmt.Sprintf("Whether destroying this resource, which irreversibly deletes the %s with its users "+
+			"and sessions, fails. It must be set to `false` in an apply of its own before the %s can be destroyed "+
+			"or replaced. Resources imported or created before this attribute existed aren't protected until an "+
+			"apply sets it. Defaults to `%t`.", what, what, defaultValue),
+	}
+}
+
+// defaultDeletionProtection returns deletionProtection, or false for imported states and states
+// written before the deletion_protection attribute existed.
+func defaultDeletionProtection(deletionProtection types.Bool) types.Bool {
+	if deletionProtection.IsNull() || deletionProtection.IsUnknown() {
+		return types.BoolValue(false)
+	}
+	return deletionProtection
+}
+
+// checkDeletionProtection adds an error to diags and returns false if deletionProtection is set,
+// so that the deletion of the resource, which what and name describe, is refused.
+func checkDeletionProtection(diags *diag.Diagnostics, deletionProtection types.Bool, what, name string) bool {
+	if !deletionProtection.ValueBool() {
+		return true
+	}
+	diags.AddError(
+		"Deletion protection is enabled",
+		fmt.Sprintf("Refusing to delete %s %s because its deletion_protection attribute is true. "+
+			"Set deletion_protection to false and apply that change first, then destroy or replace it.", what, name),
+	)
+	return false
+}
@@ -3,7 +3,6 @@ package resources
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -21,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
 	"github.com/stytchauth/stytch-management-go/v3/pkg/models/emailtemplates"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/providerdata"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/tracing"
 	"github.com/stytchauth/terraform-provider-stytch/internal/provider/utils"
 )
 
@@ -30,6 +31,8 @@ var (
 	_ resource.ResourceWithConfigure    = &emailTemplateResource{}
 	_ resource.ResourceWithImportState  = &emailTemplateResource{}
 	_ resource.ResourceWithUpgradeState = &emailTemplateResource{}
+	_ resource.ResourceWithModifyPlan   = &emailTemplateResource{}
+	_ resource.ResourceWithIdentity     = &emailTemplateResource{}
 )
 
 func NewEmailTemplateResource() resource.Resource {
@@ -37,7 +40,9 @@ func NewEmailTemplateResource() resource.Resource {
 }
 
 type emailTemplateResource struct {
-	client *api.API
+	client   *api.API
+	defaults providerdata.Defaults
+	readOnly bool
 }
 
 type emailTemplateModel struct {
@@ -338,7 +343,7 @@ func (r *emailTemplateResource) updateModelFromAPI(ctx context.Context, model *e
 	var diags diag.Diagnostics
 
 	// Update ID
-	model.ID = types.StringValue(model.ProjectSlug.ValueString() + "." + model.TemplateID.ValueString())
+	model.ID = types.StringValue(utils.FormatID(model.ProjectSlug.ValueString(), model.TemplateID.ValueString()))
 	model.TemplateID = types.StringValue(e.TemplateID)
 	if e.Name != nil {
 		model.Name = types.StringValue(*e.Name)
@@ -389,18 +394,20 @@ func (r *emailTemplateResource) Configure(ctx context.Context, req resource.Conf
 		return
 	}
 
-	client, ok := req.ProviderData.(*api.API)
+	data, ok := req.ProviderData.(*providerdata.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *api.API (stytch-management-go client), got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.defaults = data.Defaults
+	r.readOnly = data.ReadOnly
 }
 
 func (r *emailTemplateResource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
@@ -487,10 +494,11 @@ func (r *emailTemplateResource) Schema(_ context.Context, _ resource.SchemaReque
 				},
 			},
 			"project_slug": schema.StringAttribute{
-				Required:    true,
-				Description: "The slug of the project to which the email template belongs.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The slug of the project to which the email template belongs. Defaults to the provider's `default_project_slug`.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"template_id": schema.StringAttribute{
@@ -628,6 +636,17 @@ func (r *emailTemplateResource) Schema(_ context.Context, _ resource.SchemaReque
 	}
 }
 
+// emailTemplateIdentityAttributes are the attributes that identify a email template, in the order
+// of its import ID.
+var emailTemplateIdentityAttributes = []string{"project_slug", "template_id"}
+
+// IdentitySchema defines the identity schema for the resource.
+func (r *emailTemplateResource) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identitySchema(emailTemplateIdentityAttributes...)
+}
+
 func (r emailTemplateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	var data emailTemplateModel
 	diags := req.Config.Get(ctx, &data)
@@ -655,8 +674,23 @@ func (r emailTemplateResource) ValidateConfig(ctx context.Context, req resource.
 	}
 }
 
+// ModifyPlan plans the provider's default project slug if it is omitted from the configuration.
+func (r *emailTemplateResource) ModifyPlan(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+) {
+	planDefaultProjectSlug(ctx, r.defaults, true, req, resp)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *emailTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_email_template", "Create", req.Plan)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, emailTemplateIdentityAttributes...)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "create this resource") {
+		return
+	}
+
 	var plan emailTemplateModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -668,6 +702,9 @@ func (r *emailTemplateResource) Create(ctx context.Context, req resource.CreateR
 	ctx = tflog.SetField(ctx, "template_id", plan.TemplateID.ValueString())
 	tflog.Info(ctx, "Creating email template")
 
+	unlock := utils.WriteLocks.Lock(utils.ProjectLockKey(utils.LockFamilyEmailTemplates, plan.ProjectSlug.ValueString()))
+	defer unlock()
+
 	emailTemplate, diags := plan.toEmailTemplate(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -701,6 +738,10 @@ func (r *emailTemplateResource) Create(ctx context.Context, req resource.CreateR
 
 // Read refreshes the Terraform state with the latest data.
 func (r *emailTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_email_template", "Read", req.State)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, emailTemplateIdentityAttributes...)
+
 	// Get the current state
 	var state emailTemplateModel
 	diags := req.State.Get(ctx, &state)
@@ -718,6 +759,9 @@ func (r *emailTemplateResource) Read(ctx context.Context, req resource.ReadReque
 		TemplateID:  state.TemplateID.ValueString(),
 	})
 	if err != nil {
+		if removeIfNotFound(ctx, err, resp) {
+			return
+		}
 		resp.Diagnostics.AddError("Failed to read email template", err.Error())
 		return
 	}
@@ -735,6 +779,14 @@ func (r *emailTemplateResource) Read(ctx context.Context, req resource.ReadReque
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *emailTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_email_template", "Update", req.Plan)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, emailTemplateIdentityAttributes...)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "update this resource") {
+		return
+	}
+
 	var plan emailTemplateModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -746,6 +798,9 @@ func (r *emailTemplateResource) Update(ctx context.Context, req resource.UpdateR
 	ctx = tflog.SetField(ctx, "template_id", plan.TemplateID.ValueString())
 	tflog.Info(ctx, "Updating email template")
 
+	unlock := utils.WriteLocks.Lock(utils.ProjectLockKey(utils.LockFamilyEmailTemplates, plan.ProjectSlug.ValueString()))
+	defer unlock()
+
 	emailTemplate, diags := plan.toEmailTemplate(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -779,6 +834,13 @@ func (r *emailTemplateResource) Update(ctx context.Context, req resource.UpdateR
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *emailTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_email_template", "Delete", req.State)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "delete this resource") {
+		return
+	}
+
 	var state emailTemplateModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -790,6 +852,9 @@ func (r *emailTemplateResource) Delete(ctx context.Context, req resource.DeleteR
 	ctx = tflog.SetField(ctx, "template_id", state.TemplateID.ValueString())
 	tflog.Info(ctx, "Deleting email template")
 
+	unlock := utils.WriteLocks.Lock(utils.ProjectLockKey(utils.LockFamilyEmailTemplates, state.ProjectSlug.ValueString()))
+	defer unlock()
+
 	_, err := r.client.EmailTemplates.Delete(ctx, emailtemplates.DeleteRequest{
 		ProjectSlug: state.ProjectSlug.ValueString(),
 		TemplateID:  state.TemplateID.ValueString(),
@@ -803,9 +868,8 @@ func (r *emailTemplateResource) Delete(ctx context.Context, req resource.DeleteR
 }
 
 func (r *emailTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.Split(req.ID, ".")
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError("Invalid import ID", "The ID must be in the format <project_slug>.<template_id>")
+	parts, ok := importParts(ctx, req, &resp.Diagnostics, emailTemplateIdentityAttributes...)
+	if !ok {
 		return
 	}
 
@@ -3,9 +3,10 @@ package resources
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -13,10 +14,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
 	"github.com/stytchauth/stytch-management-go/v3/pkg/models/environments"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/providerdata"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/tracing"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/utils"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -24,6 +31,9 @@ var (
 	_ resource.Resource                = &environmentResource{}
 	_ resource.ResourceWithConfigure   = &environmentResource{}
 	_ resource.ResourceWithImportState = &environmentResource{}
+	_ resource.ResourceWithModifyPlan  = &environmentResource{}
+	_ resource.ResourceWithIdentity    = &environmentResource{}
+	_ resource.ResourceWithMoveState   = &environmentResource{}
 )
 
 func NewEnvironmentResource() resource.Resource {
@@ -31,16 +41,20 @@ func NewEnvironmentResource() resource.Resource {
 }
 
 type environmentResource struct {
-	client *api.API
+	client     *api.API
+	defaults   providerdata.Defaults
+	readOnly   bool
+	protection *providerdata.Protection
+	verticals  *providerdata.Verticals
 }
 
-// Note: This resource only supports TEST environments for now.
 type environmentResourceModel struct {
 	ID                                                     types.String `tfsdk:"id"`
 	ProjectSlug                                            types.String `tfsdk:"project_slug"`
 	ProjectID                                              types.String `tfsdk:"project_id"`
 	EnvironmentSlug                                        types.String `tfsdk:"environment_slug"`
 	Name                                                   types.String `tfsdk:"name"`
+	Type                                                   types.String `tfsdk:"type"`
 	OAuthCallbackID                                        types.String `tfsdk:"oauth_callback_id"`
 	CrossOrgPasswordsEnabled                               types.Bool   `tfsdk:"cross_org_passwords_enabled"`
 	UserImpersonationEnabled                               types.Bool   `tfsdk:"user_impersonation_enabled"`
@@ -52,6 +66,7 @@ type environmentResourceModel struct {
 	IDPDynamicClientRegistrationEnabled                    types.Bool   `tfsdk:"idp_dynamic_client_registration_enabled"`
 	IDPDynamicClientRegistrationAccessTokenTemplateContent types.String `tfsdk:"idp_dynamic_client_registration_access_token_template_content"`
 	CreatedAt                                              types.String `tfsdk:"created_at"`
+	DeletionProtection                                     types.Bool   `tfsdk:"deletion_protection"`
 	LastUpdated                                            types.String `tfsdk:"last_updated"`
 }
 
@@ -60,16 +75,20 @@ func (r *environmentResource) Configure(ctx context.Context, req resource.Config
 		return
 	}
 
-	client, ok := req.ProviderData.(*api.API)
+	data, ok := req.ProviderData.(*providerdata.ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *api.API (stytch-management-go client), got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.defaults = data.Defaults
+	r.readOnly = data.ReadOnly
+	r.protection = data.Protection
+	r.verticals = data.Verticals
 }
 
 func (r *environmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -78,7 +97,7 @@ func (r *environmentResource) Metadata(_ context.Context, req resource.MetadataR
 
 func (r *environmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manages a test environment within a Stytch project. This resource only supports TEST type environments.",
+		Description: "Manages an environment within a Stytch project. A `TEST` environment is created and deleted with this resource, while the project's `LIVE` environment is adopted and updated without being created or deleted. A `moved` block can move the `live_environment` of a `stytch_project` into this resource, which stops managing the project itself.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "A computed ID field used for Terraform resource management (format: project_slug.environment_slug).",
@@ -88,10 +107,11 @@ func (r *environmentResource) Schema(_ context.Context, _ resource.SchemaRequest
 				},
 			},
 			"project_slug": schema.StringAttribute{
-				Description: "The slug of the project this environment belongs to.",
-				Required:    true,
+				Description: "The slug of the project this environment belongs to. Defaults to the provider's `default_project_slug`.",
+				Optional:    true,
+				Computed:    true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"project_id": schema.StringAttribute{
@@ -114,6 +134,25 @@ func (r *environmentResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Description: "The environment's name.",
 				Required:    true,
 			},
+			"type": schema.StringAttribute{
+				Description: "The environment's type, `TEST` or `LIVE`. A `TEST` environment is created when this resource is created " +
+					"and deleted when it is destroyed. A `LIVE` environment is the project's existing live environment, which this " +
+					"resource updates without creating it, and which is left in place when the resource is destroyed. Omit " +
+					"`live_environment` from the project's `stytch_project` when managing its live environment with this resource. " +
+					"Defaults to `TEST` for new environments, while existing ones, such as an imported environment or one moved " +
+					"from a `stytch_project`, keep their type when it is omitted.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIf(requiresReplaceIfTypeInState,
+						"Changing the type of the environment replaces it.",
+						"Changing the type of the environment replaces it."),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(toStrings(environments.EnvironmentTypes())...),
+				},
+			},
 			"oauth_callback_id": schema.StringAttribute{
 				Description: "The callback ID used in OAuth requests for the environment.",
 				Computed:    true,
@@ -122,7 +161,7 @@ func (r *environmentResource) Schema(_ context.Context, _ resource.SchemaRequest
 				},
 			},
 			"cross_org_passwords_enabled": schema.BoolAttribute{
-				Description: "Whether cross-org passwords are enabled for the environment. Irrelevant for Consumer projects.",
+				Description: "Whether cross-org passwords are enabled for the environment. Can only be configured for B2B projects.",
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.Bool{
@@ -200,6 +239,7 @@ func (r *environmentResource) Schema(_ context.Context, _ resource.SchemaRequest
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"deletion_protection": deletionProtectionAttribute("environment", environmentDeletionProtectionDefault),
 			"last_updated": schema.StringAttribute{
 				Description: "Timestamp of the last Terraform update.",
 				Computed:    true,
@@ -208,7 +248,51 @@ func (r *environmentResource) Schema(_ context.Context, _ resource.SchemaRequest
 	}
 }
 
+// environmentIdentityAttributes are the attributes that identify a environment, in the order of its
+// import ID.
+var environmentIdentityAttributes = []string{"project_slug", "environment_slug"}
+
+// IdentitySchema defines the identity schema for the resource.
+func (r *environmentResource) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identitySchema(environmentIdentityAttributes...)
+}
+
+// ModifyPlan plans the provider's default project slug if it is omitted from the configuration,
+// plans the type of a new environment, checks B2B-only attributes against the project's vertical and guards protected environments.
+func (r *environmentResource) ModifyPlan(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+) {
+	planDefaultProjectSlug(ctx, r.defaults, true, req, resp)
+	planEnvironmentType(ctx, req, resp)
+	checkProjectVertical(ctx, r.verticals, "stytch_environment", "", req, resp,
+		verticalAttribute{path: path.Root("cross_org_passwords_enabled"), vertical: projects.VerticalB2B},
+	)
+
+	replaceAttributes := []path.Path{path.Root("project_slug"), path.Root("environment_slug")}
+	// A state written before the type attribute existed isn't replaced when the type is planned,
+	// as requiresReplaceIfTypeInState decides.
+	if !req.State.Raw.IsNull() {
+		var stateType types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("type"), &stateType)...)
+		if !stateType.IsNull() {
+			replaceAttributes = append(replaceAttributes, path.Root("type"))
+		}
+	}
+	guardProtectedEnvironment(ctx, r.protection, path.Root("environment_slug"), false, req, resp,
+		replaceAttributes...)
+}
+
 func (r *environmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_environment", "Create", req.Plan)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, environmentIdentityAttributes...)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "create this resource") {
+		return
+	}
+
 	var plan environmentResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -220,6 +304,12 @@ func (r *environmentResource) Create(ctx context.Context, req resource.CreateReq
 	ctx = tflog.SetField(ctx, "project_id", plan.ProjectID.ValueString())
 	ctx = tflog.SetField(ctx, "environment_slug", plan.EnvironmentSlug.ValueString())
 	ctx = tflog.SetField(ctx, "environment_name", plan.Name.ValueString())
+
+	if plan.Type.ValueString() == string(environments.EnvironmentTypeLive) {
+		r.adoptLiveEnvironment(ctx, plan, resp)
+		return
+	}
+
 	tflog.Info(ctx, "Creating test environment")
 
 	// Create the environment with TEST type
@@ -268,7 +358,9 @@ func (r *environmentResource) Create(ctx context.Context, req resource.CreateReq
 	tflog.Info(ctx, "Created test environment")
 
 	// Build the state
+	deletionProtection := plan.DeletionProtection
 	plan = refreshFromEnvironment(createResp.Environment)
+	plan.DeletionProtection = deletionProtection
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
 	diags = resp.State.Set(ctx, plan)
@@ -276,6 +368,10 @@ func (r *environmentResource) Create(ctx context.Context, req resource.CreateReq
 }
 
 func (r *environmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_environment", "Read", req.State)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, environmentIdentityAttributes...)
+
 	var state environmentResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -293,15 +389,20 @@ func (r *environmentResource) Read(ctx context.Context, req resource.ReadRequest
 		EnvironmentSlug: state.EnvironmentSlug.ValueString(),
 	})
 	if err != nil {
+		if removeIfNotFound(ctx, err, resp) {
+			return
+		}
 		resp.Diagnostics.AddError("Failed to get environment", err.Error())
 		return
 	}
 
-	// Validate that this is a TEST environment
-	if getResp.Environment.Type != environments.EnvironmentTypeTest {
+	// Imported environments, and environments read before the type attribute existed, take the type
+	// they have.
+	if !state.Type.IsNull() && getResp.Environment.Type != environments.EnvironmentType(state.Type.ValueString()) {
 		resp.Diagnostics.AddError(
 			"Invalid environment type",
-			fmt.Sprintf("This resource only supports TEST environments. The environment read has type: %s", getResp.Environment.Type),
+			fmt.Sprintf("This resource manages a %s environment, but the environment read has type: %s",
+				state.Type.ValueString(), getResp.Environment.Type),
 		)
 		return
 	}
@@ -309,13 +410,23 @@ func (r *environmentResource) Read(ctx context.Context, req resource.ReadRequest
 	tflog.Info(ctx, "Read environment")
 
 	// Update the state
+	deletionProtection := defaultDeletionProtection(state.DeletionProtection)
 	state = refreshFromEnvironment(getResp.Environment)
+	state.DeletionProtection = deletionProtection
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 }
 
 func (r *environmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_environment", "Update", req.Plan)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, environmentIdentityAttributes...)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "update this resource") {
+		return
+	}
+
 	var plan environmentResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -335,41 +446,7 @@ func (r *environmentResource) Update(ctx context.Context, req resource.UpdateReq
 	ctx = tflog.SetField(ctx, "environment_slug", state.EnvironmentSlug.ValueString())
 	tflog.Info(ctx, "Updating environment")
 
-	updateReq := environments.UpdateRequest{
-		ProjectSlug:     state.ProjectSlug.ValueString(),
-		EnvironmentSlug: state.EnvironmentSlug.ValueString(),
-	}
-
-	if !plan.Name.Equal(state.Name) {
-		updateReq.Name = ptr(plan.Name.ValueString())
-	}
-	if !plan.CrossOrgPasswordsEnabled.IsNull() && !plan.CrossOrgPasswordsEnabled.Equal(state.CrossOrgPasswordsEnabled) {
-		updateReq.CrossOrgPasswordsEnabled = ptr(plan.CrossOrgPasswordsEnabled.ValueBool())
-	}
-	if !plan.UserImpersonationEnabled.IsNull() && !plan.UserImpersonationEnabled.Equal(state.UserImpersonationEnabled) {
-		updateReq.UserImpersonationEnabled = ptr(plan.UserImpersonationEnabled.ValueBool())
-	}
-	if !plan.ZeroDowntimeSessionMigrationURL.IsNull() && !plan.ZeroDowntimeSessionMigrationURL.Equal(state.ZeroDowntimeSessionMigrationURL) {
-		updateReq.ZeroDowntimeSessionMigrationURL = ptr(plan.ZeroDowntimeSessionMigrationURL.ValueString())
-	}
-	if !plan.UserLockSelfServeEnabled.IsNull() && !plan.UserLockSelfServeEnabled.Equal(state.UserLockSelfServeEnabled) {
-		updateReq.UserLockSelfServeEnabled = ptr(plan.UserLockSelfServeEnabled.ValueBool())
-	}
-	if !plan.UserLockThreshold.IsNull() && !plan.UserLockThreshold.Equal(state.UserLockThreshold) {
-		updateReq.UserLockThreshold = ptr(int(plan.UserLockThreshold.ValueInt32()))
-	}
-	if !plan.UserLockTTL.IsNull() && !plan.UserLockTTL.Equal(state.UserLockTTL) {
-		updateReq.UserLockTTL = ptr(int(plan.UserLockTTL.ValueInt32()))
-	}
-	if !plan.IDPAuthorizationURL.IsNull() && !plan.IDPAuthorizationURL.Equal(state.IDPAuthorizationURL) {
-		updateReq.IDPAuthorizationURL = ptr(plan.IDPAuthorizationURL.ValueString())
-	}
-	if !plan.IDPDynamicClientRegistrationEnabled.IsNull() && !plan.IDPDynamicClientRegistrationEnabled.Equal(state.IDPDynamicClientRegistrationEnabled) {
-		updateReq.IDPDynamicClientRegistrationEnabled = ptr(plan.IDPDynamicClientRegistrationEnabled.ValueBool())
-	}
-	if !plan.IDPDynamicClientRegistrationAccessTokenTemplateContent.IsNull() && !plan.IDPDynamicClientRegistrationAccessTokenTemplateContent.Equal(state.IDPDynamicClientRegistrationAccessTokenTemplateContent) {
-		updateReq.IDPDynamicClientRegistrationAccessTokenTemplateContent = ptr(plan.IDPDynamicClientRegistrationAccessTokenTemplateContent.ValueString())
-	}
+	updateReq := buildEnvironmentUpdateRequest(plan, state)
 
 	updateResp, err := r.client.Environments.Update(ctx, updateReq)
 	if err != nil {
@@ -381,6 +458,7 @@ func (r *environmentResource) Update(ctx context.Context, req resource.UpdateReq
 
 	// Update the state
 	state = refreshFromEnvironment(updateResp.Environment)
+	state.DeletionProtection = plan.DeletionProtection
 	state.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
 	diags = resp.State.Set(ctx, state)
@@ -388,6 +466,13 @@ func (r *environmentResource) Update(ctx context.Context, req resource.UpdateReq
 }
 
 func (r *environmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_environment", "Delete", req.State)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "delete this resource") {
+		return
+	}
+
 	var state environmentResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -400,6 +485,22 @@ func (r *environmentResource) Delete(ctx context.Context, req resource.DeleteReq
 	ctx = tflog.SetField(ctx, "environment_slug", state.EnvironmentSlug.ValueString())
 	tflog.Info(ctx, "Deleting environment")
 
+	// The live environment can only be deleted with its project.
+	if state.Type.ValueString() == string(environments.EnvironmentTypeLive) {
+		tflog.Info(ctx, "Removed live environment from state without deleting it")
+		resp.Diagnostics.AddWarning(
+			"Live environment not deleted",
+			fmt.Sprintf("The live environment %s was removed from the Terraform state, but it still exists: "+
+				"it is only deleted with its project.", state.ID.ValueString()),
+		)
+		return
+	}
+
+	if !checkDeletionProtection(&resp.Diagnostics, defaultDeletionProtection(state.DeletionProtection),
+		"environment", state.ID.ValueString()) {
+		return
+	}
+
 	_, err := r.client.Environments.Delete(ctx, environments.DeleteRequest{
 		ProjectSlug:     state.ProjectSlug.ValueString(),
 		EnvironmentSlug: state.EnvironmentSlug.ValueString(),
@@ -417,28 +518,200 @@ func (r *environmentResource) ImportState(ctx context.Context, req resource.Impo
 	tflog.Info(ctx, "Importing environment")
 
 	// Import ID format: project_slug.environment_slug
-	parts := strings.Split(req.ID, ".")
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError(
-			"Invalid import ID format",
-			fmt.Sprintf("Expected import ID format: project_slug.environment_slug, got: %s", req.ID),
-		)
+	parts, ok := importParts(ctx, req, &resp.Diagnostics, environmentIdentityAttributes...)
+	if !ok {
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_slug"), parts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_slug"), parts[1])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), utils.FormatID(parts...))...)
+}
+
+// adoptLiveEnvironment creates a LIVE environment resource by updating the project's existing live
+// environment with the planned values.
+func (r *environmentResource) adoptLiveEnvironment(
+	ctx context.Context, plan environmentResourceModel, resp *resource.CreateResponse,
+) {
+	tflog.Info(ctx, "Adopting live environment")
+
+	env, ok := r.getLiveEnvironment(ctx, plan, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "environment_slug", env.EnvironmentSlug)
+	updateResp, err := r.client.Environments.Update(ctx, buildEnvironmentUpdateRequest(plan, refreshFromEnvironment(env)))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update live environment", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Adopted live environment")
+
+	deletionProtection := plan.DeletionProtection
+	plan = refreshFromEnvironment(updateResp.Environment)
+	plan.DeletionProtection = deletionProtection
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// getLiveEnvironment returns the live environment of the planned project, which is the environment
+// with the planned slug if it is configured. It returns false, with an error in diags, if there is
+// no such live environment.
+func (r *environmentResource) getLiveEnvironment(
+	ctx context.Context, plan environmentResourceModel, diags *diag.Diagnostics,
+) (environments.Environment, bool) {
+	projectSlug := plan.ProjectSlug.ValueString()
+
+	if !plan.EnvironmentSlug.IsUnknown() {
+		getResp, err := r.client.Environments.Get(ctx, environments.GetRequest{
+			ProjectSlug:     projectSlug,
+			EnvironmentSlug: plan.EnvironmentSlug.ValueString(),
+		})
+		if err != nil {
+			diags.AddError("Failed to get live environment", err.Error())
+			return environments.Environment{}, false
+		}
+		if getResp.Environment.Type != environments.EnvironmentTypeLive {
+			diags.AddError(
+				"Invalid environment type",
+				fmt.Sprintf("Environment %s of project %q has type %s, but this resource manages a LIVE environment.",
+					plan.EnvironmentSlug.ValueString(), projectSlug, getResp.Environment.Type),
+			)
+			return environments.Environment{}, false
+		}
+		return getResp.Environment, true
+	}
+
+	getAllResp, err := r.client.Environments.GetAll(ctx, environments.GetAllRequest{
+		ProjectSlug: projectSlug,
+	})
+	if err != nil {
+		diags.AddError("Failed to list environments", err.Error())
+		return environments.Environment{}, false
+	}
+	for _, env := range getAllResp.Environments {
+		if env.Type == environments.EnvironmentTypeLive {
+			return env, true
+		}
+	}
+	diags.AddError(
+		"No live environment",
+		fmt.Sprintf("Project %q has no live environment to manage. This resource doesn't create live environments: "+
+			"create it with the live_environment attribute of the project's stytch_project.", projectSlug),
+	)
+	return environments.Environment{}, false
+}
+
+// planEnvironmentType plans a TEST environment when type is omitted from the configuration of a new
+// environment. Existing environments keep the type in their state instead, so that omitting it
+// doesn't replace the LIVE environment moved from a stytch_project.
+func planEnvironmentType(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+) {
+	if resp.Diagnostics.HasError() || req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() {
+		return
+	}
+
+	var configType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &configType)...)
+	if resp.Diagnostics.HasError() || !configType.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("type"),
+		types.StringValue(string(environments.EnvironmentTypeTest)))...)
+}
+
+// requiresReplaceIfTypeInState replaces the environment when its type changes, but not when the
+// type is first planned for a state written before the type attribute existed, which is always a
+// TEST environment.
+func requiresReplaceIfTypeInState(
+	_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse,
+) {
+	resp.RequiresReplace = !req.StateValue.IsNull()
+}
+
+// MoveState moves the live_environment of a stytch_project into a LIVE stytch_environment, for
+// live environments to be managed on their own. The project itself is no longer managed afterwards.
+func (r *environmentResource) MoveState(ctx context.Context) []resource.StateMover {
+	var projectSchema resource.SchemaResponse
+	(&projectResource{}).Schema(ctx, resource.SchemaRequest{}, &projectSchema)
+
+	return []resource.StateMover{
+		{
+			SourceSchema: &projectSchema.Schema,
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				r.moveStateFromProject(ctx, projectSchema.Schema.Version, req, resp)
+			},
+		},
+	}
+}
+
+func (r *environmentResource) moveStateFromProject(
+	ctx context.Context, version int64, req resource.MoveStateRequest, resp *resource.MoveStateResponse,
+) {
+	if req.SourceTypeName != "stytch_project" {
+		return
+	}
+	// Older states don't have the live_environment attribute, which only a refresh adds.
+	if req.SourceState == nil || req.SourceSchemaVersion != version {
+		resp.Diagnostics.AddError(
+			"Unable to move project",
+			fmt.Sprintf("The stytch_project state has schema version %d, but only version %d can be moved. "+
+				"Refresh the state with the current provider version, then move it.", req.SourceSchemaVersion, version),
+		)
+		return
+	}
+
+	var source projectModel
+	diags := req.SourceState.Get(ctx, &source)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if source.LiveEnvironment.IsNull() {
+		resp.Diagnostics.AddError(
+			"Unable to move project",
+			fmt.Sprintf("Project %q has no live environment in its state to move. Refresh the state, then move it.",
+				source.ProjectSlug.ValueString()),
+		)
+		return
+	}
+
+	var liveEnv environmentModel
+	diags = source.LiveEnvironment.As(ctx, &liveEnv, basetypes.ObjectAsOptions{})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "project_slug", source.ProjectSlug.ValueString())
+	ctx = tflog.SetField(ctx, "environment_slug", liveEnv.EnvironmentSlug.ValueString())
+	tflog.Info(ctx, "Moving project live environment into environment")
+
+	target := environmentFromLiveEnvironment(source.ProjectSlug, liveEnv)
+	// The project's deletion protection doesn't carry over, since the defaults of the two resources
+	// differ and a LIVE environment is never deleted with this resource anyway.
+	target.DeletionProtection = types.BoolValue(environmentDeletionProtectionDefault)
+	target.LastUpdated = source.LastUpdated
+
+	diags = resp.TargetState.Set(ctx, target)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, &resp.TargetState, resp.TargetIdentity, &resp.Diagnostics, environmentIdentityAttributes...)
 }
 
 // Helper function to refresh the environment model from API response.
 func refreshFromEnvironment(env environments.Environment) environmentResourceModel {
 	return environmentResourceModel{
-		ID:                                  types.StringValue(fmt.Sprintf("%s.%s", env.ProjectSlug, env.EnvironmentSlug)),
+		ID:                                  types.StringValue(utils.FormatID(env.ProjectSlug, env.EnvironmentSlug)),
 		ProjectSlug:                         types.StringValue(env.ProjectSlug),
 		ProjectID:                           types.StringValue(env.ProjectID),
 		EnvironmentSlug:                     types.StringValue(env.EnvironmentSlug),
 		Name:                                types.StringValue(env.Name),
+		Type:                                types.StringValue(string(env.Type)),
 		OAuthCallbackID:                     types.StringValue(env.OAuthCallbackID),
 		CrossOrgPasswordsEnabled:            types.BoolValue(env.CrossOrgPasswordsEnabled),
 		UserImpersonationEnabled:            types.BoolValue(env.UserImpersonationEnabled),
@@ -452,3 +725,90 @@ func refreshFromEnvironment(env environments.Environment) environmentResourceMod
 		CreatedAt: types.StringValue(env.CreatedAt.Format(time.RFC3339)),
 	}
 }
+
+// liveEnvironmentFromEnvironment returns the live_environment of a project made of the attributes
+// it shares with an environment resource.
+func liveEnvironmentFromEnvironment(env environmentResourceModel) environmentModel {
+	return environmentModel{
+		EnvironmentSlug:                     env.EnvironmentSlug,
+		ProjectID:                           env.ProjectID,
+		Name:                                env.Name,
+		OAuthCallbackID:                     env.OAuthCallbackID,
+		CrossOrgPasswordsEnabled:            env.CrossOrgPasswordsEnabled,
+		UserImpersonationEnabled:            env.UserImpersonationEnabled,
+		ZeroDowntimeSessionMigrationURL:     env.ZeroDowntimeSessionMigrationURL,
+		UserLockSelfServeEnabled:            env.UserLockSelfServeEnabled,
+		UserLockThreshold:                   env.UserLockThreshold,
+		UserLockTTL:                         env.UserLockTTL,
+		IDPAuthorizationURL:                 env.IDPAuthorizationURL,
+		IDPDynamicClientRegistrationEnabled: env.IDPDynamicClientRegistrationEnabled,
+		IDPDynamicClientRegistrationAccessTokenTemplateContent: env.IDPDynamicClientRegistrationAccessTokenTemplateContent,
+		CreatedAt: env.CreatedAt,
+	}
+}
+
+// environmentFromLiveEnvironment returns the environment resource model of the live_environment of
+// the given project, without its deletion_protection and last_updated attributes.
+func environmentFromLiveEnvironment(projectSlug types.String, env environmentModel) environmentResourceModel {
+	return environmentResourceModel{
+		ID:                                  types.StringValue(utils.FormatID(projectSlug.ValueString(), env.EnvironmentSlug.ValueString())),
+		ProjectSlug:                         projectSlug,
+		ProjectID:                           env.ProjectID,
+		EnvironmentSlug:                     env.EnvironmentSlug,
+		Name:                                env.Name,
+		Type:                                types.StringValue(string(environments.EnvironmentTypeLive)),
+		OAuthCallbackID:                     env.OAuthCallbackID,
+		CrossOrgPasswordsEnabled:            env.CrossOrgPasswordsEnabled,
+		UserImpersonationEnabled:            env.UserImpersonationEnabled,
+		ZeroDowntimeSessionMigrationURL:     env.ZeroDowntimeSessionMigrationURL,
+		UserLockSelfServeEnabled:            env.UserLockSelfServeEnabled,
+		UserLockThreshold:                   env.UserLockThreshold,
+		UserLockTTL:                         env.UserLockTTL,
+		IDPAuthorizationURL:                 env.IDPAuthorizationURL,
+		IDPDynamicClientRegistrationEnabled: env.IDPDynamicClientRegistrationEnabled,
+		IDPDynamicClientRegistrationAccessTokenTemplateContent: env.IDPDynamicClientRegistrationAccessTokenTemplateContent,
+		CreatedAt: env.CreatedAt,
+	}
+}
+
+// buildEnvironmentUpdateRequest returns the request that updates the environment in state with
+// the planned values that differ from it.
+func buildEnvironmentUpdateRequest(plan, state environmentResourceModel) environments.UpdateRequest {
+	updateReq := environments.UpdateRequest{
+		ProjectSlug:     state.ProjectSlug.ValueString(),
+		EnvironmentSlug: state.EnvironmentSlug.ValueString(),
+	}
+
+	if !plan.Name.Equal(state.Name) {
+		updateReq.Name = ptr(plan.Name.ValueString())
+	}
+	if !plan.CrossOrgPasswordsEnabled.IsNull() && !plan.CrossOrgPasswordsEnabled.IsUnknown() && !plan.CrossOrgPasswordsEnabled.Equal(state.CrossOrgPasswordsEnabled) {
+		updateReq.CrossOrgPasswordsEnabled = ptr(plan.CrossOrgPasswordsEnabled.ValueBool())
+	}
+	if !plan.UserImpersonationEnabled.IsNull() && !plan.UserImpersonationEnabled.IsUnknown() && !plan.UserImpersonationEnabled.Equal(state.UserImpersonationEnabled) {
+		updateReq.UserImpersonationEnabled = ptr(plan.UserImpersonationEnabled.ValueBool())
+	}
+	if !plan.ZeroDowntimeSessionMigrationURL.IsNull() && !plan.ZeroDowntimeSessionMigrationURL.IsUnknown() && !plan.ZeroDowntimeSessionMigrationURL.Equal(state.ZeroDowntimeSessionMigrationURL) {
+		updateReq.ZeroDowntimeSessionMigrationURL = ptr(plan.ZeroDowntimeSessionMigrationURL.ValueString())
+	}
+	if !plan.UserLockSelfServeEnabled.IsNull() && !plan.UserLockSelfServeEnabled.IsUnknown() && !plan.UserLockSelfServeEnabled.Equal(state.UserLockSelfServeEnabled) {
+		updateReq.UserLockSelfServeEnabled = ptr(plan.UserLockSelfServeEnabled.ValueBool())
+	}
+	if !plan.UserLockThreshold.IsNull() && !plan.UserLockThreshold.IsUnknown() && !plan.UserLockThreshold.Equal(state.UserLockThreshold) {
+		updateReq.UserLockThreshold = ptr(int(plan.UserLockThreshold.ValueInt32()))
+	}
+	if !plan.UserLockTTL.IsNull() && !plan.UserLockTTL.IsUnknown() && !plan.UserLockTTL.Equal(state.UserLockTTL) {
+		updateReq.UserLockTTL = ptr(int(plan.UserLockTTL.ValueInt32()))
+	}
+	if !plan.IDPAuthorizationURL.IsNull() && !plan.IDPAuthorizationURL.IsUnknown() && !plan.IDPAuthorizationURL.Equal(state.IDPAuthorizationURL) {
+		updateReq.IDPAuthorizationURL = ptr(plan.IDPAuthorizationURL.ValueString())
+	}
+	if !plan.IDPDynamicClientRegistrationEnabled.IsNull() && !plan.IDPDynamicClientRegistrationEnabled.IsUnknown() && !plan.IDPDynamicClientRegistrationEnabled.Equal(state.IDPDynamicClientRegistrationEnabled) {
+		updateReq.IDPDynamicClientRegistrationEnabled = ptr(plan.IDPDynamicClientRegistrationEnabled.ValueBool())
+	}
+	if !plan.IDPDynamicClientRegistrationAccessTokenTemplateContent.IsNull() && !plan.IDPDynamicClientRegistrationAccessTokenTemplateContent.IsUnknown() && !plan.IDPDynamicClientRegistrationAccessTokenTemplateContent.Equal(state.IDPDynamicClientRegistrationAccessTokenTemplateContent) {
+		updateReq.IDPDynamicClientRegistrationAccessTokenTemplateContent = ptr(plan.IDPDynamicClientRegistrationAccessTokenTemplateContent.ValueString())
+	}
+
+	return updateReq
+}
@@ -1,10 +1,14 @@
 package resources_test
 
 import (
+	"context"
+	"fmt"
 	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/environments"
 	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
 	"github.com/stytchauth/terraform-provider-stytch/internal/provider/testutil"
 )
@@ -21,6 +25,7 @@ func TestAccEnvironmentResource(t *testing.T) {
 					Name:                "Environment Test Project",
 					Vertical:            projects.VerticalConsumer,
 					LiveEnvironmentName: strPtr("Production"),
+					DeletionProtection:  testutil.Unprotected(),
 				}) + testutil.EnvironmentResource(testutil.EnvironmentResourceArgs{
 					ProjectSlug:     "stytch_project.test.project_slug",
 					Name:            "Test Environment",
@@ -48,6 +53,7 @@ func TestAccEnvironmentResource(t *testing.T) {
 					Name:                "Environment Test Project",
 					Vertical:            projects.VerticalConsumer,
 					LiveEnvironmentName: strPtr("Production"),
+					DeletionProtection:  testutil.Unprotected(),
 				}) + testutil.EnvironmentResource(testutil.EnvironmentResourceArgs{
 					ProjectSlug: "stytch_project.test.project_slug",
 					Name:        "Updated Test Environment",
@@ -78,6 +84,7 @@ func TestAccEnvironmentResourceWithConfig(t *testing.T) {
 					Name:                "Config Test Project",
 					Vertical:            projects.VerticalB2B,
 					LiveEnvironmentName: strPtr("Production"),
+					DeletionProtection:  testutil.Unprotected(),
 				}),
 			},
 			{
@@ -86,6 +93,7 @@ func TestAccEnvironmentResourceWithConfig(t *testing.T) {
 					Name:                "Config Test Project",
 					Vertical:            projects.VerticalB2B,
 					LiveEnvironmentName: strPtr("Production"),
+					DeletionProtection:  testutil.Unprotected(),
 				}) + testutil.EnvironmentResource(testutil.EnvironmentResourceArgs{
 					ProjectSlug:                  "stytch_project.test.project_slug",
 					Name:                         "Test with Config",
@@ -115,6 +123,7 @@ func TestAccEnvironmentResourceWithConfig(t *testing.T) {
 					Name:                "Config Test Project",
 					Vertical:            projects.VerticalB2B,
 					LiveEnvironmentName: strPtr("Production"),
+					DeletionProtection:  testutil.Unprotected(),
 				}) + testutil.EnvironmentResource(testutil.EnvironmentResourceArgs{
 					ProjectSlug:              "stytch_project.test.project_slug",
 					Name:                     "Test with Updated Config",
@@ -134,3 +143,59 @@ func TestAccEnvironmentResourceWithConfig(t *testing.T) {
 		},
 	})
 }
+
+func TestAccEnvironmentResourceLive(t *testing.T) {
+	projectSlug := "test-acc-environment-resource-live"
+	projectConfig := testutil.ProviderConfig + fmt.Sprintf(`
+resource "stytch_project" "test" {
+  project_slug        = "%s"
+  name                = "Live Environment Test Project"
+  vertical            = "CONSUMER"
+  deletion_protection = false
+}
+`, projectSlug)
+	liveEnvironmentConfig := func(name string) string {
+		return projectConfig + testutil.EnvironmentResource(testutil.EnvironmentResourceArgs{
+			ProjectSlug: "stytch_project.test.project_slug",
+			Name:        name,
+			Type:        strPtr(string(environments.EnvironmentTypeLive)),
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: projectConfig,
+				Check: func(*terraform.State) error {
+					// Create the live environment outside of Terraform.
+					_, err := testutil.Client().Environments.Create(context.Background(), environments.CreateRequest{
+						ProjectSlug: projectSlug,
+						Name:        "Production",
+						Type:        environments.EnvironmentTypeLive,
+					})
+					return err
+				},
+			},
+			{
+				// Adopt the live environment.
+				Config: liveEnvironmentConfig("Production"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("stytch_environment.test", "type", "LIVE"),
+					resource.TestCheckResourceAttr("stytch_environment.test", "name", "Production"),
+					resource.TestCheckResourceAttrSet("stytch_environment.test", "environment_slug"),
+					resource.TestCheckNoResourceAttr("stytch_project.test", "live_environment.environment_slug"),
+				),
+			},
+			{
+				// Update it.
+				Config: liveEnvironmentConfig("Live"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("stytch_environment.test", "type", "LIVE"),
+					resource.TestCheckResourceAttr("stytch_environment.test", "name", "Live"),
+				),
+			},
+			// Destroying the resource leaves the live environment to be deleted with the project.
+		},
+	})
+}
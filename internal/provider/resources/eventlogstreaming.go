@@ -23,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
 	"github.com/stytchauth/stytch-management-go/v3/pkg/models/eventlogstreaming"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/providerdata"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/tracing"
 	"github.com/stytchauth/terraform-provider-stytch/internal/provider/utils"
 )
 
@@ -32,6 +34,8 @@ var (
 	_ resource.ResourceWithConfigure    = &eventLogStreamingResource{}
 	_ resource.ResourceWithImportState  = &eventLogStreamingResource{}
 	_ resource.ResourceWithUpgradeState = &eventLogStreamingResource{}
+	_ resource.ResourceWithModifyPlan   = &eventLogStreamingResource{}
+	_ resource.ResourceWithIdentity     = &eventLogStreamingResource{}
 )
 
 // preserveSensitiveValuePlanModifier is a plan modifier that preserves sensitive values
@@ -69,7 +73,10 @@ func NewEventLogStreamingResource() resource.Resource {
 }
 
 type eventLogStreamingResource struct {
-	client *api.API
+	client     *api.API
+	defaults   providerdata.Defaults
+	readOnly   bool
+	protection *providerdata.Protection
 }
 
 type eventLogStreamingModel struct {
@@ -255,16 +262,19 @@ func (r *eventLogStreamingResource) Configure(_ context.Context, req resource.Co
 		return
 	}
 
-	client, ok := req.ProviderData.(*api.API)
+	data, ok := req.ProviderData.(*providerdata.ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *api.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.defaults = data.Defaults
+	r.readOnly = data.ReadOnly
+	r.protection = data.Protection
 }
 
 func (r *eventLogStreamingResource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
@@ -316,7 +326,7 @@ func (r *eventLogStreamingResource) upgradeEventLogStreamingStateV0ToV1(
 	}
 
 	newState := eventLogStreamingModel{
-		ID:                types.StringValue(fmt.Sprintf("%s.%s.%s", projectSlug, environmentSlug, destinationType)),
+		ID:                types.StringValue(utils.FormatID(projectSlug, environmentSlug, destinationType)),
 		ProjectSlug:       types.StringValue(projectSlug),
 		EnvironmentSlug:   types.StringValue(environmentSlug),
 		DestinationType:   types.StringValue(destinationType),
@@ -353,17 +363,19 @@ func (r *eventLogStreamingResource) Schema(_ context.Context, _ resource.SchemaR
 				},
 			},
 			"project_slug": schema.StringAttribute{
-				Required:    true,
-				Description: "The slug of the project for which to configure event log streaming",
+				Optional:    true,
+				Computed:    true,
+				Description: "The slug of the project for which to configure event log streaming. Defaults to the provider's `default_project_slug`.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"environment_slug": schema.StringAttribute{
-				Required:    true,
-				Description: "The slug of the environment for which to configure event log streaming",
+				Optional:    true,
+				Computed:    true,
+				Description: "The slug of the environment for which to configure event log streaming. Defaults to the provider's `default_environment_slug`.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"last_updated": schema.StringAttribute{
@@ -448,6 +460,17 @@ func (r *eventLogStreamingResource) Schema(_ context.Context, _ resource.SchemaR
 	}
 }
 
+// eventLogStreamingIdentityAttributes are the attributes that identify a event log streaming
+// config, in the order of its import ID.
+var eventLogStreamingIdentityAttributes = []string{"project_slug", "environment_slug", "destination_type"}
+
+// IdentitySchema defines the identity schema for the resource.
+func (r *eventLogStreamingResource) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identitySchema(eventLogStreamingIdentityAttributes...)
+}
+
 // ValidateConfig validates the configuration for the resource.
 func (r *eventLogStreamingResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	var data eventLogStreamingModel
@@ -489,8 +512,27 @@ func (r *eventLogStreamingResource) ValidateConfig(ctx context.Context, req reso
 	}
 }
 
+// ModifyPlan plans the provider's default project and environment slugs for the ones omitted
+// from the configuration and guards protected environments.
+func (r *eventLogStreamingResource) ModifyPlan(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+) {
+	planDefaultProjectSlug(ctx, r.defaults, true, req, resp)
+	planDefaultEnvironmentSlug(ctx, r.defaults, true, req, resp)
+	guardProtectedEnvironment(ctx, r.protection, path.Root("environment_slug"), false, req, resp,
+		path.Root("project_slug"), path.Root("environment_slug"), path.Root("destination_type"))
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *eventLogStreamingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_event_log_streaming", "Create", req.Plan)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, eventLogStreamingIdentityAttributes...)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "create this resource") {
+		return
+	}
+
 	var plan eventLogStreamingModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -544,7 +586,7 @@ func (r *eventLogStreamingResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
-	plan.ID = types.StringValue(fmt.Sprintf("%s.%s.%s", plan.ProjectSlug.ValueString(), plan.EnvironmentSlug.ValueString(), plan.DestinationType.ValueString()))
+	plan.ID = types.StringValue(utils.FormatID(plan.ProjectSlug.ValueString(), plan.EnvironmentSlug.ValueString(), plan.DestinationType.ValueString()))
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
 	diags = resp.State.Set(ctx, plan)
@@ -553,6 +595,10 @@ func (r *eventLogStreamingResource) Create(ctx context.Context, req resource.Cre
 
 // Read refreshes the Terraform state with the latest data.
 func (r *eventLogStreamingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_event_log_streaming", "Read", req.State)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, eventLogStreamingIdentityAttributes...)
+
 	var state eventLogStreamingModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -571,6 +617,9 @@ func (r *eventLogStreamingResource) Read(ctx context.Context, req resource.ReadR
 		DestinationType: eventlogstreaming.DestinationType(state.DestinationType.ValueString()),
 	})
 	if err != nil {
+		if removeIfNotFound(ctx, err, resp) {
+			return
+		}
 		resp.Diagnostics.AddError("Failed to get event log streaming", err.Error())
 		return
 	}
@@ -590,6 +639,14 @@ func (r *eventLogStreamingResource) Read(ctx context.Context, req resource.ReadR
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *eventLogStreamingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_event_log_streaming", "Update", req.Plan)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, eventLogStreamingIdentityAttributes...)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "update this resource") {
+		return
+	}
+
 	var plan eventLogStreamingModel
 	var state eventLogStreamingModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -665,6 +722,13 @@ func (r *eventLogStreamingResource) Update(ctx context.Context, req resource.Upd
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *eventLogStreamingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_event_log_streaming", "Delete", req.State)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "delete this resource") {
+		return
+	}
+
 	var state eventLogStreamingModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -692,12 +756,8 @@ func (r *eventLogStreamingResource) Delete(ctx context.Context, req resource.Del
 
 func (r *eventLogStreamingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Import ID format: project_slug.environment_slug.destination_type
-	parts := strings.Split(req.ID, ".")
-	if len(parts) != 3 {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Import ID must be in format 'project_slug.environment_slug.destination_type', got: %s", req.ID),
-		)
+	parts, ok := importParts(ctx, req, &resp.Diagnostics, eventLogStreamingIdentityAttributes...)
+	if !ok {
 		return
 	}
 
@@ -705,7 +765,7 @@ func (r *eventLogStreamingResource) ImportState(ctx context.Context, req resourc
 	environmentSlug := parts[1]
 	destinationType := parts[2]
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), utils.FormatID(parts...))...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_slug"), projectSlug)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_slug"), environmentSlug)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("destination_type"), destinationType)...)
@@ -0,0 +1,73 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/utils"
+)
+
+// identitySchema returns the identity schema of a resource identified by the given attributes,
+// which are also attributes of its state and the parts of its import ID, in order.
+func identitySchema(attributes ...string) identityschema.Schema {
+	identityAttributes := make(map[string]identityschema.Attribute, len(attributes))
+	for _, attribute := range attributes {
+		identityAttributes[attribute] = identityschema.StringAttribute{
+			RequiredForImport: true,
+			Description:       fmt.Sprintf("The %s of the resource.", strings.ReplaceAll(attribute, "_", " ")),
+		}
+	}
+	return identityschema.Schema{Attributes: identityAttributes}
+}
+
+// setIdentity sets the identity of a resource from its state once a create, read or update has
+// succeeded. It is meant to be deferred at the start of those operations, so that it covers every
+// way they set the state.
+func setIdentity(
+	ctx context.Context, state *tfsdk.State, identity *tfsdk.ResourceIdentity, diags *diag.Diagnostics, attributes ...string,
+) {
+	// Nothing to identify if the operation failed or the resource is gone, and nothing to set if
+	// Terraform doesn't support identities.
+	if diags.HasError() || identity == nil || state.Raw.IsNull() {
+		return
+	}
+	for _, attribute := range attributes {
+		var value types.String
+		diags.Append(state.GetAttribute(ctx, path.Root(attribute), &value)...)
+		diags.Append(identity.SetAttribute(ctx, path.Root(attribute), value)...)
+	}
+}
+
+// importParts returns the values of the given attributes of the resource being imported, taken
+// from its import ID, or from its identity when it is imported by identity. It returns false, with
+// an error in diags, if they can't be determined.
+func importParts(
+	ctx context.Context, req resource.ImportStateRequest, diags *diag.Diagnostics, attributes ...string,
+) ([]string, bool) {
+	if req.ID != "" || req.Identity == nil {
+		parts, err := utils.ParseID(req.ID, attributes...)
+		if err != nil {
+			diags.AddError("Invalid import ID", err.Error())
+			return nil, false
+		}
+		return parts, true
+	}
+
+	parts := make([]string, len(attributes))
+	for i, attribute := range attributes {
+		var value types.String
+		diags.Append(req.Identity.GetAttribute(ctx, path.Root(attribute), &value)...)
+		if diags.HasError() {
+			return nil, false
+		}
+		parts[i] = value.ValueString()
+	}
+	return parts, true
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -17,6 +18,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
 	"github.com/stytchauth/stytch-management-go/v3/pkg/models/jwttemplates"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/providerdata"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/tracing"
 	"github.com/stytchauth/terraform-provider-stytch/internal/provider/utils"
 )
 
@@ -26,6 +29,8 @@ var (
 	_ resource.ResourceWithConfigure    = &jwtTemplateResource{}
 	_ resource.ResourceWithImportState  = &jwtTemplateResource{}
 	_ resource.ResourceWithUpgradeState = &jwtTemplateResource{}
+	_ resource.ResourceWithModifyPlan   = &jwtTemplateResource{}
+	_ resource.ResourceWithIdentity     = &jwtTemplateResource{}
 )
 
 func NewJWTTemplateResource() resource.Resource {
@@ -33,7 +38,10 @@ func NewJWTTemplateResource() resource.Resource {
 }
 
 type jwtTemplateResource struct {
-	client *api.API
+	client     *api.API
+	defaults   providerdata.Defaults
+	readOnly   bool
+	protection *providerdata.Protection
 }
 
 type jwtTemplateModel struct {
@@ -43,6 +51,8 @@ type jwtTemplateModel struct {
 	TemplateType    types.String `tfsdk:"template_type"`
 	TemplateContent types.String `tfsdk:"template_content"`
 	CustomAudience  types.String `tfsdk:"custom_audience"`
+	CreateMode      types.String `tfsdk:"create_mode"`
+	OnDestroy       types.String `tfsdk:"on_destroy"`
 	LastUpdated     types.String `tfsdk:"last_updated"`
 }
 
@@ -77,16 +87,19 @@ func (r *jwtTemplateResource) Configure(ctx context.Context, req resource.Config
 		return
 	}
 
-	client, ok := req.ProviderData.(*api.API)
+	data, ok := req.ProviderData.(*providerdata.ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *api.API (stytch-management-go client), got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.defaults = data.Defaults
+	r.readOnly = data.ReadOnly
+	r.protection = data.Protection
 }
 
 func (r *jwtTemplateResource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
@@ -136,11 +149,13 @@ func (r *jwtTemplateResource) upgradeJWTTemplateStateV0ToV1(
 
 	templateType := strings.ToUpper(prior.TemplateType.ValueString())
 	newState := jwtTemplateModel{
-		ID:              types.StringValue(fmt.Sprintf("%s.%s.%s", projectSlug, environmentSlug, templateType)),
+		ID:              types.StringValue(utils.FormatID(projectSlug, environmentSlug, templateType)),
 		ProjectSlug:     types.StringValue(projectSlug),
 		EnvironmentSlug: types.StringValue(environmentSlug),
 		TemplateType:    types.StringValue(templateType),
 		LastUpdated:     types.StringValue(time.Now().Format(time.RFC850)),
+		CreateMode:      types.StringValue(createModeOverwrite),
+		OnDestroy:       types.StringValue(onDestroyReset),
 	}
 
 	r.updateModelFromAPI(&newState, &getResp.JWTTemplate)
@@ -168,17 +183,19 @@ func (r *jwtTemplateResource) Schema(_ context.Context, _ resource.SchemaRequest
 				},
 			},
 			"project_slug": schema.StringAttribute{
-				Required:    true,
-				Description: "The slug of the project to which the JWT template belongs.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The slug of the project to which the JWT template belongs. Defaults to the provider's `default_project_slug`.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"environment_slug": schema.StringAttribute{
-				Required:    true,
-				Description: "The slug of the environment to which the JWT template belongs.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The slug of the environment to which the JWT template belongs. Defaults to the provider's `default_environment_slug`.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"template_type": schema.StringAttribute{
@@ -200,6 +217,8 @@ func (r *jwtTemplateResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Computed:    true,
 				Description: "An optional custom audience for the JWT template.",
 			},
+			"create_mode": createModeAttribute("JWT template"),
+			"on_destroy":  onDestroyAttribute("JWT template", "resets it to an empty template"),
 			"last_updated": schema.StringAttribute{
 				Description: "Timestamp of the last Terraform update.",
 				Computed:    true,
@@ -208,15 +227,47 @@ func (r *jwtTemplateResource) Schema(_ context.Context, _ resource.SchemaRequest
 	}
 }
 
+// jwtTemplateIdentityAttributes are the attributes that identify a JWT template, in the order of
+// its import ID.
+var jwtTemplateIdentityAttributes = []string{"project_slug", "environment_slug", "template_type"}
+
+// IdentitySchema defines the identity schema for the resource.
+func (r *jwtTemplateResource) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identitySchema(jwtTemplateIdentityAttributes...)
+}
+
 // updateModelFromAPI updates the model with values from the API response.
 func (r *jwtTemplateResource) updateModelFromAPI(model *jwtTemplateModel, template *jwttemplates.JWTTemplate) {
-	model.ID = types.StringValue(fmt.Sprintf("%s.%s.%s", model.ProjectSlug.ValueString(), model.EnvironmentSlug.ValueString(), model.TemplateType.ValueString()))
+	model.ID = types.StringValue(utils.FormatID(model.ProjectSlug.ValueString(), model.EnvironmentSlug.ValueString(), model.TemplateType.ValueString()))
 	model.TemplateContent = types.StringValue(template.TemplateContent)
 	model.CustomAudience = types.StringValue(template.CustomAudience)
 }
 
+// ModifyPlan plans the provider's default project and environment slugs for the ones omitted
+// from the configuration, checks the create_mode of a new resource and guards protected
+// environments.
+func (r *jwtTemplateResource) ModifyPlan(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+) {
+	planDefaultProjectSlug(ctx, r.defaults, true, req, resp)
+	planDefaultEnvironmentSlug(ctx, r.defaults, true, req, resp)
+	r.planCreateMode(ctx, req, resp)
+	guardProtectedEnvironment(ctx, r.protection, path.Root("environment_slug"), false, req, resp,
+		path.Root("project_slug"), path.Root("environment_slug"), path.Root("template_type"))
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *jwtTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_jwt_template", "Create", req.Plan)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, jwtTemplateIdentityAttributes...)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "create this resource") {
+		return
+	}
+
 	var plan jwtTemplateModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -229,6 +280,36 @@ func (r *jwtTemplateResource) Create(ctx context.Context, req resource.CreateReq
 	ctx = tflog.SetField(ctx, "template_type", plan.TemplateType.ValueString())
 	tflog.Info(ctx, "Creating JWT template")
 
+	existing := r.captureOriginalConfig(ctx, plan.ProjectSlug.ValueString(), plan.EnvironmentSlug.ValueString(),
+		jwttemplates.JWTTemplateType(plan.TemplateType.ValueString()), resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	switch plan.CreateMode.ValueString() {
+	case createModeRequireDefault:
+		if differences := r.nonDefaultValues(plan, existing); len(differences) > 0 {
+			addNonDefaultError(&resp.Diagnostics, "JWT template", differences)
+			return
+		}
+	case createModeAdopt:
+		// The plan has already adopted the existing values, so there is nothing to set.
+		existingModel := plan
+		r.updateModelFromAPI(&existingModel, existing)
+		if conflicts := compareModels(existingModel, plan); len(conflicts) > 0 {
+			addAdoptConflictError(&resp.Diagnostics, "JWT template", conflicts)
+			return
+		}
+
+		tflog.Info(ctx, "JWT template adopted")
+
+		diags = resp.State.Set(ctx, existingModel)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
 	createResp, err := r.client.JWTTemplates.Set(ctx, jwttemplates.SetRequest{
 		ProjectSlug:     plan.ProjectSlug.ValueString(),
 		EnvironmentSlug: plan.EnvironmentSlug.ValueString(),
@@ -244,7 +325,6 @@ func (r *jwtTemplateResource) Create(ctx context.Context, req resource.CreateReq
 	tflog.Info(ctx, "JWT template created")
 
 	r.updateModelFromAPI(&plan, &createResp.JWTTemplate)
-	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -252,6 +332,10 @@ func (r *jwtTemplateResource) Create(ctx context.Context, req resource.CreateReq
 
 // Read refreshes the Terraform state with the latest data.
 func (r *jwtTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_jwt_template", "Read", req.State)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, jwtTemplateIdentityAttributes...)
+
 	var state jwtTemplateModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -270,6 +354,9 @@ func (r *jwtTemplateResource) Read(ctx context.Context, req resource.ReadRequest
 		JWTTemplateType: jwttemplates.JWTTemplateType(state.TemplateType.ValueString()),
 	})
 	if err != nil {
+		if removeIfNotFound(ctx, err, resp) {
+			return
+		}
 		resp.Diagnostics.AddError("Failed to read JWT template", err.Error())
 		return
 	}
@@ -278,12 +365,23 @@ func (r *jwtTemplateResource) Read(ctx context.Context, req resource.ReadRequest
 
 	r.updateModelFromAPI(&state, &getResp.JWTTemplate)
 
+	// States written before create_mode and on_destroy existed have no value for them.
+	state.CreateMode = defaultCreateMode(state.CreateMode)
+	state.OnDestroy = defaultOnDestroy(state.OnDestroy)
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *jwtTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_jwt_template", "Update", req.Plan)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, jwtTemplateIdentityAttributes...)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "update this resource") {
+		return
+	}
+
 	var plan jwtTemplateModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -317,9 +415,118 @@ func (r *jwtTemplateResource) Update(ctx context.Context, req resource.UpdateReq
 	resp.Diagnostics.Append(diags...)
 }
 
+// captureOriginalConfig saves the environment's JWT template before the resource takes it over, so
+// that it can be restored on destroy, and returns it.
+func (r *jwtTemplateResource) captureOriginalConfig(
+	ctx context.Context, projectSlug, environmentSlug string, templateType jwttemplates.JWTTemplateType,
+	private privateStateSetter, diags *diag.Diagnostics,
+) *jwttemplates.JWTTemplate {
+	getResp, err := r.client.JWTTemplates.Get(ctx, jwttemplates.GetRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: environmentSlug,
+		JWTTemplateType: templateType,
+	})
+	if err != nil {
+		diags.AddError("Failed to get original JWT template", err.Error())
+		return nil
+	}
+	saveOriginalConfig(ctx, private, getResp.JWTTemplate, diags)
+	return &getResp.JWTTemplate
+}
+
+// planCreateMode checks the environment's JWT template against the create_mode of a new resource,
+// and plans its current values for the attributes omitted from the configuration when adopting it.
+// It is checked again when the plan is applied, which is the only check when the environment
+// doesn't exist yet.
+func (r *jwtTemplateResource) planCreateMode(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+) {
+	if resp.Diagnostics.HasError() || req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, config jwtTemplateModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.CreateMode.ValueString() == createModeOverwrite || plan.CreateMode.IsUnknown() ||
+		plan.ProjectSlug.IsUnknown() || plan.EnvironmentSlug.IsUnknown() || plan.TemplateType.IsUnknown() {
+		return
+	}
+
+	getResp, err := r.client.JWTTemplates.Get(ctx, jwttemplates.GetRequest{
+		ProjectSlug:     plan.ProjectSlug.ValueString(),
+		EnvironmentSlug: plan.EnvironmentSlug.ValueString(),
+		JWTTemplateType: jwttemplates.JWTTemplateType(plan.TemplateType.ValueString()),
+	})
+	if utils.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get JWT template", err.Error())
+		return
+	}
+
+	switch plan.CreateMode.ValueString() {
+	case createModeRequireDefault:
+		if differences := r.nonDefaultValues(plan, &getResp.JWTTemplate); len(differences) > 0 {
+			addNonDefaultError(&resp.Diagnostics, "JWT template", differences)
+			return
+		}
+	case createModeAdopt:
+		existing := plan
+		r.updateModelFromAPI(&existing, &getResp.JWTTemplate)
+		if conflicts := adoptModel(&plan, config, existing); len(conflicts) > 0 {
+			addAdoptConflictError(&resp.Diagnostics, "JWT template", conflicts)
+			return
+		}
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+	}
+}
+
+// nonDefaultValues returns the attributes of template, the environment's JWT template, that differ
+// from the empty template.
+func (r *jwtTemplateResource) nonDefaultValues(
+	model jwtTemplateModel, template *jwttemplates.JWTTemplate,
+) []attributeDifference {
+	defaults := DefaultJWTTemplate(model.ProjectSlug.ValueString(), model.EnvironmentSlug.ValueString(),
+		jwttemplates.JWTTemplateType(model.TemplateType.ValueString()))
+	defaultModel := model
+	r.updateModelFromAPI(&defaultModel, &jwttemplates.JWTTemplate{
+		TemplateContent: defaults.TemplateContent,
+		CustomAudience:  defaults.CustomAudience,
+	})
+	existingModel := model
+	r.updateModelFromAPI(&existingModel, template)
+	return compareModels(existingModel, defaultModel)
+}
+
+// DefaultJWTTemplate returns the request that resets a JWT template for an environment to its
+// empty default value.
+func DefaultJWTTemplate(
+	projectSlug, environmentSlug string, templateType jwttemplates.JWTTemplateType,
+) jwttemplates.SetRequest {
+	return jwttemplates.SetRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: environmentSlug,
+		JWTTemplateType: templateType,
+		TemplateContent: "{}",
+		CustomAudience:  "",
+	}
+}
+
 // Delete deletes the resource and removes the Terraform state on success.
 // Note: JWT templates cannot be deleted via API, they can only be reset to default values.
 func (r *jwtTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_jwt_template", "Delete", req.State)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "delete this resource") {
+		return
+	}
+
 	var state jwtTemplateModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -330,16 +537,42 @@ func (r *jwtTemplateResource) Delete(ctx context.Context, req resource.DeleteReq
 	ctx = tflog.SetField(ctx, "project_slug", state.ProjectSlug.ValueString())
 	ctx = tflog.SetField(ctx, "environment_slug", state.EnvironmentSlug.ValueString())
 	ctx = tflog.SetField(ctx, "template_type", state.TemplateType.ValueString())
+
+	switch state.OnDestroy.ValueString() {
+	case onDestroyAbandon:
+		tflog.Info(ctx, "Abandoning JWT template")
+		return
+	case onDestroyRestore:
+		var original jwttemplates.JWTTemplate
+		if !loadOriginalConfig(ctx, req.Private, &original, &resp.Diagnostics) {
+			return
+		}
+
+		tflog.Info(ctx, "Deleting JWT template (restoring the original template)")
+		_, err := r.client.JWTTemplates.Set(ctx, jwttemplates.SetRequest{
+			ProjectSlug:     state.ProjectSlug.ValueString(),
+			EnvironmentSlug: state.EnvironmentSlug.ValueString(),
+			JWTTemplateType: jwttemplates.JWTTemplateType(state.TemplateType.ValueString()),
+			TemplateContent: original.TemplateContent,
+			CustomAudience:  original.CustomAudience,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to restore JWT template", err.Error())
+			return
+		}
+
+		tflog.Info(ctx, "JWT template restored to its original values")
+		return
+	}
+
 	tflog.Info(ctx, "Deleting JWT template (resetting to default values)")
 
 	// JWT templates cannot be deleted via API, only reset to empty/default values
-	_, err := r.client.JWTTemplates.Set(ctx, jwttemplates.SetRequest{
-		ProjectSlug:     state.ProjectSlug.ValueString(),
-		EnvironmentSlug: state.EnvironmentSlug.ValueString(),
-		JWTTemplateType: jwttemplates.JWTTemplateType(state.TemplateType.ValueString()),
-		TemplateContent: "{}",
-		CustomAudience:  "",
-	})
+	_, err := r.client.JWTTemplates.Set(ctx, DefaultJWTTemplate(
+		state.ProjectSlug.ValueString(),
+		state.EnvironmentSlug.ValueString(),
+		jwttemplates.JWTTemplateType(state.TemplateType.ValueString()),
+	))
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to reset JWT template to default values", err.Error())
 		return
@@ -354,17 +587,16 @@ func (r *jwtTemplateResource) ImportState(ctx context.Context, req resource.Impo
 	tflog.Info(ctx, "Importing JWT template")
 
 	// Import ID format: project_slug.environment_slug.template_type
-	parts := strings.Split(req.ID, ".")
-	if len(parts) != 3 {
-		resp.Diagnostics.AddError(
-			"Invalid import ID format",
-			fmt.Sprintf("Expected import ID format: project_slug.environment_slug.template_type, got: %s", req.ID),
-		)
+	parts, ok := importParts(ctx, req, &resp.Diagnostics, jwtTemplateIdentityAttributes...)
+	if !ok {
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), utils.FormatID(parts...))...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_slug"), parts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_slug"), parts[1])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("template_type"), parts[2])...)
+
+	r.captureOriginalConfig(ctx, parts[0], parts[1], jwttemplates.JWTTemplateType(parts[2]), resp.Private,
+		&resp.Diagnostics)
 }
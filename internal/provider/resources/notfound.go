@@ -0,0 +1,23 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/utils"
+)
+
+// removeIfNotFound removes the resource from the state if err tells that it no longer exists, such
+// as after it was deleted in the Stytch dashboard, so that Terraform plans to create it again
+// instead of failing. It returns whether the resource was removed.
+func removeIfNotFound(ctx context.Context, err error, resp *resource.ReadResponse) bool {
+	if !utils.IsNotFound(err) {
+		return false
+	}
+	tflog.Warn(ctx, "Resource not found, removing it from the state", map[string]interface{}{
+		"error": err.Error(),
+	})
+	resp.State.RemoveResource(ctx)
+	return true
+}
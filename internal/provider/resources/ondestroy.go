@@ -0,0 +1,102 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// What a singleton configuration resource does to its configuration when it is destroyed.
+const (
+	// onDestroyRestore writes back the configuration the environment had before the resource was
+	// created or imported.
+	onDestroyRestore = "restore"
+	// onDestroyReset resets the configuration, which is what destroying these resources has always done.
+	onDestroyReset = "reset"
+	// onDestroyAbandon leaves the configuration as it is.
+	onDestroyAbandon = "abandon"
+)
+
+// originalConfigKey is the private state key of the configuration captured for onDestroyRestore.
+const originalConfigKey = "original_config"
+
+// onDestroyAttribute returns the schema of the on_destroy attribute of a singleton configuration
+// resource. what names the configuration, such as "password config", and reset describes what
+// onDestroyReset does to it, such as "resets it to its defaults".
+func onDestroyAttribute(what, reset string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString(onDestroyReset),
+		Description: fmt.Sprintf("What to do with the %s when this resource is destroyed: "+
+			"`restore` writes back the %s the environment had before the resource was created or imported, "+
+			"`reset` %s and `abandon` leaves it as it is. Defaults to `reset`.", what, what, reset),
+		Validators: []validator.String{
+			stringvalidator.OneOf(onDestroyRestore, onDestroyReset, onDestroyAbandon),
+		},
+	}
+}
+
+// defaultOnDestroy returns onDestroy, or onDestroyReset for states written before the on_destroy
+// attribute existed.
+func defaultOnDestroy(onDestroy types.String) types.String {
+	if onDestroy.IsNull() || onDestroy.IsUnknown() {
+		return types.StringValue(onDestroyReset)
+	}
+	return onDestroy
+}
+
+// privateStateSetter is implemented by the private state of create and import responses.
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// privateStateGetter is implemented by the private state of delete requests.
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// saveOriginalConfig captures the configuration an environment had before the resource took it
+// over, so that it can be restored when the resource is destroyed with on_destroy = "restore".
+func saveOriginalConfig(ctx context.Context, private privateStateSetter, original interface{}, diags *diag.Diagnostics) {
+	value, err := json.Marshal(original)
+	if err != nil {
+		diags.AddError("Failed to save the original configuration", err.Error())
+		return
+	}
+	diags.Append(private.SetKey(ctx, originalConfigKey, value)...)
+}
+
+// loadOriginalConfig loads the configuration captured by saveOriginalConfig into original. It
+// returns false if there is nothing to restore, adding a warning to diags if the resource was
+// created before original configurations were captured, in which case the configuration is left as
+// it is.
+func loadOriginalConfig(ctx context.Context, private privateStateGetter, original interface{}, diags *diag.Diagnostics) bool {
+	value, getDiags := private.GetKey(ctx, originalConfigKey)
+	diags.Append(getDiags...)
+	if diags.HasError() {
+		return false
+	}
+	if len(value) == 0 {
+		tflog.Warn(ctx, "No original configuration to restore")
+		diags.AddWarning(
+			"No original configuration to restore",
+			"The configuration the environment had before this resource was created or imported wasn't captured, "+
+				"so it is left as it is. This happens for resources created with an earlier version of the provider.",
+		)
+		return false
+	}
+	if err := json.Unmarshal(value, original); err != nil {
+		diags.AddError("Failed to load the original configuration", err.Error())
+		return false
+	}
+	return true
+}
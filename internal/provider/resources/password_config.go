@@ -3,15 +3,16 @@ package resources
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
 	"github.com/stytchauth/stytch-management-go/v3/pkg/models/passwordstrengthconfig"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/providerdata"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/tracing"
 	"github.com/stytchauth/terraform-provider-stytch/internal/provider/utils"
 )
 
@@ -30,6 +33,8 @@ var (
 	_ resource.ResourceWithImportState      = &passwordConfigResource{}
 	_ resource.ResourceWithConfigValidators = &passwordConfigResource{}
 	_ resource.ResourceWithUpgradeState     = &passwordConfigResource{}
+	_ resource.ResourceWithModifyPlan       = &passwordConfigResource{}
+	_ resource.ResourceWithIdentity         = &passwordConfigResource{}
 )
 
 func NewPasswordConfigResource() resource.Resource {
@@ -37,7 +42,10 @@ func NewPasswordConfigResource() resource.Resource {
 }
 
 type passwordConfigResource struct {
-	client *api.API
+	client     *api.API
+	defaults   providerdata.Defaults
+	readOnly   bool
+	protection *providerdata.Protection
 }
 
 type passwordConfigModel struct {
@@ -50,6 +58,8 @@ type passwordConfigModel struct {
 	ValidationPolicy            types.String `tfsdk:"validation_policy"`
 	LudsMinPasswordLength       types.Int64  `tfsdk:"luds_min_password_length"`
 	LudsMinPasswordComplexity   types.Int64  `tfsdk:"luds_min_password_complexity"`
+	CreateMode                  types.String `tfsdk:"create_mode"`
+	OnDestroy                   types.String `tfsdk:"on_destroy"`
 	LastUpdated                 types.String `tfsdk:"last_updated"`
 }
 
@@ -102,17 +112,20 @@ func (r *passwordConfigResource) Configure(
 		return
 	}
 
-	client, ok := req.ProviderData.(*api.API)
+	data, ok := req.ProviderData.(*providerdata.ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *api.API (stytch-management-go client), got: %T. Please report "+
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report "+
 				"this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.defaults = data.Defaults
+	r.readOnly = data.ReadOnly
+	r.protection = data.Protection
 }
 
 func (r *passwordConfigResource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
@@ -163,10 +176,12 @@ func (r *passwordConfigResource) upgradePasswordConfigStateV0ToV1(
 	}
 
 	newState := passwordConfigModel{
-		ID:              types.StringValue(fmt.Sprintf("%s.%s", projectSlug, environmentSlug)),
+		ID:              types.StringValue(utils.FormatID(projectSlug, environmentSlug)),
 		ProjectSlug:     types.StringValue(projectSlug),
 		EnvironmentSlug: types.StringValue(environmentSlug),
 		LastUpdated:     types.StringValue(time.Now().Format(time.RFC850)),
+		CreateMode:      types.StringValue(createModeOverwrite),
+		OnDestroy:       types.StringValue(onDestroyReset),
 	}
 
 	r.updateModelFromAPI(&newState, &getResp.PasswordStrengthConfig)
@@ -196,36 +211,44 @@ func (r *passwordConfigResource) Schema(
 				},
 			},
 			"project_slug": schema.StringAttribute{
-				Required:    true,
-				Description: "The slug of the project to which the password config belongs.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The slug of the project to which the password config belongs. Defaults to the provider's `default_project_slug`.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"environment_slug": schema.StringAttribute{
-				Required:    true,
-				Description: "The slug of the environment to which the password config belongs.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The slug of the environment to which the password config belongs. Defaults to the provider's `default_environment_slug`.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"check_breach_on_creation": schema.BoolAttribute{
 				Optional:    true,
 				Computed:    true,
-				Default:     booldefault.StaticBool(true),
-				Description: "Whether to use the HaveIBeenPwned database to detect password breaches when a user first creates their password.",
+				Description: "Whether to use the HaveIBeenPwned database to detect password breaches when a user first creates their password. " + adoptedDefaultDescription,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"check_breach_on_authentication": schema.BoolAttribute{
 				Optional:    true,
 				Computed:    true,
-				Default:     booldefault.StaticBool(true),
-				Description: "Whether to use the HaveIBeenPwned database to detect password breaches when a user authenticates.",
+				Description: "Whether to use the HaveIBeenPwned database to detect password breaches when a user authenticates. " + adoptedDefaultDescription,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"validate_on_authentication": schema.BoolAttribute{
 				Optional:    true,
 				Computed:    true,
-				Default:     booldefault.StaticBool(true),
-				Description: "Whether to require a password reset on authentication if a user's current password no longer meets the environment's current policy requirements.",
+				Description: "Whether to require a password reset on authentication if a user's current password no longer meets the environment's current policy requirements. " + adoptedDefaultDescription,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"validation_policy": schema.StringAttribute{
 				Required:    true,
@@ -255,6 +278,8 @@ func (r *passwordConfigResource) Schema(
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"create_mode": createModeAttribute("password config"),
+			"on_destroy":  onDestroyAttribute("password config", "resets it to its defaults"),
 			"last_updated": schema.StringAttribute{
 				Description: "Timestamp of the last Terraform update.",
 				Computed:    true,
@@ -263,10 +288,52 @@ func (r *passwordConfigResource) Schema(
 	}
 }
 
+// adoptedDefaultDescription documents the default of the boolean password config attributes, which
+// planCreateModeDefaults plans.
+const adoptedDefaultDescription = "Defaults to `true`, unless `create_mode` is `adopt`, which keeps the " +
+	"environment's current value when it is omitted from the configuration."
+
+// passwordConfigIdentityAttributes are the attributes that identify a password config, in the order
+// of its import ID.
+var passwordConfigIdentityAttributes = []string{"project_slug", "environment_slug"}
+
+// IdentitySchema defines the identity schema for the resource.
+func (r *passwordConfigResource) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identitySchema(passwordConfigIdentityAttributes...)
+}
+
+// ModifyPlan plans the provider's default project and environment slugs for the ones omitted
+// from the configuration, plans the defaults of the other omitted attributes unless the password
+// config is adopted, checks the create_mode of a new resource and guards protected environments.
+func (r *passwordConfigResource) ModifyPlan(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+) {
+	planDefaultProjectSlug(ctx, r.defaults, true, req, resp)
+	planDefaultEnvironmentSlug(ctx, r.defaults, true, req, resp)
+	planCreateModeDefaults(ctx, req, resp, map[string]attr.Value{
+		"check_breach_on_creation":       types.BoolValue(true),
+		"check_breach_on_authentication": types.BoolValue(true),
+		"validate_on_authentication":     types.BoolValue(true),
+	})
+	r.planCreateMode(ctx, req, resp)
+	guardProtectedEnvironment(ctx, r.protection, path.Root("environment_slug"), false, req, resp,
+		path.Root("project_slug"), path.Root("environment_slug"))
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *passwordConfigResource) Create(
 	ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse,
 ) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_password_config", "Create", req.Plan)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, passwordConfigIdentityAttributes...)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "create this resource") {
+		return
+	}
+
 	var plan passwordConfigModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -278,6 +345,43 @@ func (r *passwordConfigResource) Create(
 	ctx = tflog.SetField(ctx, "environment_slug", plan.EnvironmentSlug.ValueString())
 	tflog.Info(ctx, "Creating password config")
 
+	existing := r.captureOriginalConfig(ctx, plan.ProjectSlug.ValueString(), plan.EnvironmentSlug.ValueString(),
+		resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(utils.FormatID(plan.ProjectSlug.ValueString(), plan.EnvironmentSlug.ValueString()))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	switch plan.CreateMode.ValueString() {
+	case createModeRequireDefault:
+		if differences := r.nonDefaultValues(plan, existing); len(differences) > 0 {
+			addNonDefaultError(&resp.Diagnostics, "password config", differences)
+			return
+		}
+	case createModeAdopt:
+		// The plan has usually adopted the existing values already, unless the environment wasn't
+		// known when it was planned.
+		var config passwordConfigModel
+		resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		existingModel := plan
+		r.updateModelFromAPI(&existingModel, existing)
+		if conflicts := adoptModel(&plan, config, existingModel); len(conflicts) > 0 {
+			addAdoptConflictError(&resp.Diagnostics, "password config", conflicts)
+			return
+		}
+
+		tflog.Info(ctx, "Adopted password config")
+
+		diags = resp.State.Set(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
 	setRequest := passwordstrengthconfig.SetRequest{
 		ProjectSlug:                 plan.ProjectSlug.ValueString(),
 		EnvironmentSlug:             plan.EnvironmentSlug.ValueString(),
@@ -305,8 +409,6 @@ func (r *passwordConfigResource) Create(
 
 	tflog.Info(ctx, "Created password config")
 
-	plan.ID = types.StringValue(fmt.Sprintf("%s.%s", plan.ProjectSlug.ValueString(), plan.EnvironmentSlug.ValueString()))
-	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 	r.updateModelFromAPI(&plan, &setResp.PasswordStrengthConfig)
 
 	diags = resp.State.Set(ctx, plan)
@@ -317,6 +419,10 @@ func (r *passwordConfigResource) Create(
 func (r *passwordConfigResource) Read(
 	ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse,
 ) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_password_config", "Read", req.State)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, passwordConfigIdentityAttributes...)
+
 	var state passwordConfigModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -333,6 +439,9 @@ func (r *passwordConfigResource) Read(
 		EnvironmentSlug: state.EnvironmentSlug.ValueString(),
 	})
 	if err != nil {
+		if removeIfNotFound(ctx, err, resp) {
+			return
+		}
 		resp.Diagnostics.AddError("Failed to get password config", err.Error())
 		return
 	}
@@ -341,6 +450,9 @@ func (r *passwordConfigResource) Read(
 
 	r.updateModelFromAPI(&state, &getResp.PasswordStrengthConfig)
 
+	// States written before create_mode and on_destroy existed have no value for them.
+	state.CreateMode = defaultCreateMode(state.CreateMode)
+	state.OnDestroy = defaultOnDestroy(state.OnDestroy)
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 }
@@ -349,6 +461,14 @@ func (r *passwordConfigResource) Read(
 func (r *passwordConfigResource) Update(
 	ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse,
 ) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_password_config", "Update", req.Plan)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, passwordConfigIdentityAttributes...)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "update this resource") {
+		return
+	}
+
 	var plan passwordConfigModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -394,11 +514,31 @@ func (r *passwordConfigResource) Update(
 	resp.Diagnostics.Append(diags...)
 }
 
+// DefaultPasswordConfig returns the request that resets the password config for an environment to
+// the Stytch defaults.
+func DefaultPasswordConfig(projectSlug, environmentSlug string) passwordstrengthconfig.SetRequest {
+	return passwordstrengthconfig.SetRequest{
+		ProjectSlug:                 projectSlug,
+		EnvironmentSlug:             environmentSlug,
+		CheckBreachOnCreation:       true,
+		CheckBreachOnAuthentication: true,
+		ValidateOnAuthentication:    true,
+		ValidationPolicy:            passwordstrengthconfig.ValidationPolicyZXCVBN,
+	}
+}
+
 // Delete deletes the resource and removes the Terraform state on success.
 // The actual policy is not deleted, only reset to Stytch default values.
 func (r *passwordConfigResource) Delete(
 	ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse,
 ) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_password_config", "Delete", req.State)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "delete this resource") {
+		return
+	}
+
 	var state passwordConfigModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -408,19 +548,42 @@ func (r *passwordConfigResource) Delete(
 
 	ctx = tflog.SetField(ctx, "project_slug", state.ProjectSlug.ValueString())
 	ctx = tflog.SetField(ctx, "environment_slug", state.EnvironmentSlug.ValueString())
-	tflog.Info(ctx, "Deleting password config (resetting to defaults)")
 
-	// Reset to default values
-	setRequest := passwordstrengthconfig.SetRequest{
-		ProjectSlug:                 state.ProjectSlug.ValueString(),
-		EnvironmentSlug:             state.EnvironmentSlug.ValueString(),
-		CheckBreachOnCreation:       true,
-		CheckBreachOnAuthentication: true,
-		ValidateOnAuthentication:    true,
-		ValidationPolicy:            passwordstrengthconfig.ValidationPolicyZXCVBN,
+	switch state.OnDestroy.ValueString() {
+	case onDestroyAbandon:
+		tflog.Info(ctx, "Abandoning password config")
+		return
+	case onDestroyRestore:
+		var original passwordstrengthconfig.PasswordStrengthConfig
+		if !loadOriginalConfig(ctx, req.Private, &original, &resp.Diagnostics) {
+			return
+		}
+
+		tflog.Info(ctx, "Deleting password config (restoring the original config)")
+		_, err := r.client.PasswordStrengthConfig.Set(ctx, passwordstrengthconfig.SetRequest{
+			ProjectSlug:                 state.ProjectSlug.ValueString(),
+			EnvironmentSlug:             state.EnvironmentSlug.ValueString(),
+			CheckBreachOnCreation:       original.CheckBreachOnCreation,
+			CheckBreachOnAuthentication: original.CheckBreachOnAuthentication,
+			ValidateOnAuthentication:    original.ValidateOnAuthentication,
+			ValidationPolicy:            original.ValidationPolicy,
+			LudsMinPasswordLength:       original.LudsMinPasswordLength,
+			LudsMinPasswordComplexity:   original.LudsMinPasswordComplexity,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to restore password config", err.Error())
+			return
+		}
+
+		tflog.Info(ctx, "Deleted password config (restored the original config)")
+		return
 	}
 
-	_, err := r.client.PasswordStrengthConfig.Set(ctx, setRequest)
+	tflog.Info(ctx, "Deleting password config (resetting to defaults)")
+
+	// Reset to default values
+	_, err := r.client.PasswordStrengthConfig.Set(ctx,
+		DefaultPasswordConfig(state.ProjectSlug.ValueString(), state.EnvironmentSlug.ValueString()))
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to reset password config", err.Error())
 		return
@@ -437,18 +600,16 @@ func (r *passwordConfigResource) ImportState(
 	tflog.Info(ctx, "Importing password config")
 
 	// Import ID format: project_slug.environment_slug
-	parts := strings.Split(req.ID, ".")
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError(
-			"Invalid import ID format",
-			fmt.Sprintf("Expected import ID format: project_slug.environment_slug, got: %s", req.ID),
-		)
+	parts, ok := importParts(ctx, req, &resp.Diagnostics, passwordConfigIdentityAttributes...)
+	if !ok {
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), utils.FormatID(parts...))...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_slug"), parts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_slug"), parts[1])...)
+
+	r.captureOriginalConfig(ctx, parts[0], parts[1], resp.Private, &resp.Diagnostics)
 }
 
 // ConfigValidators returns validators for the resource configuration.
@@ -500,6 +661,94 @@ func (v *ludsFieldsValidator) ValidateResource(ctx context.Context, req resource
 	}
 }
 
+// captureOriginalConfig saves the environment's password config before the resource takes it over,
+// so that it can be restored on destroy, and returns it.
+func (r *passwordConfigResource) captureOriginalConfig(
+	ctx context.Context, projectSlug, environmentSlug string, private privateStateSetter, diags *diag.Diagnostics,
+) *passwordstrengthconfig.PasswordStrengthConfig {
+	getResp, err := r.client.PasswordStrengthConfig.Get(ctx, passwordstrengthconfig.GetRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: environmentSlug,
+	})
+	if err != nil {
+		diags.AddError("Failed to get original password config", err.Error())
+		return nil
+	}
+	saveOriginalConfig(ctx, private, getResp.PasswordStrengthConfig, diags)
+	return &getResp.PasswordStrengthConfig
+}
+
+// planCreateMode checks the environment's password config against the create_mode of a new
+// resource, and plans its current values for the attributes omitted from the configuration when
+// adopting it. It is checked again when the plan is applied, which is the only check when the
+// environment doesn't exist yet.
+func (r *passwordConfigResource) planCreateMode(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+) {
+	if resp.Diagnostics.HasError() || req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, config passwordConfigModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.CreateMode.ValueString() == createModeOverwrite || plan.CreateMode.IsUnknown() ||
+		plan.ProjectSlug.IsUnknown() || plan.EnvironmentSlug.IsUnknown() {
+		return
+	}
+
+	getResp, err := r.client.PasswordStrengthConfig.Get(ctx, passwordstrengthconfig.GetRequest{
+		ProjectSlug:     plan.ProjectSlug.ValueString(),
+		EnvironmentSlug: plan.EnvironmentSlug.ValueString(),
+	})
+	if utils.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get password config", err.Error())
+		return
+	}
+
+	switch plan.CreateMode.ValueString() {
+	case createModeRequireDefault:
+		if differences := r.nonDefaultValues(plan, &getResp.PasswordStrengthConfig); len(differences) > 0 {
+			addNonDefaultError(&resp.Diagnostics, "password config", differences)
+			return
+		}
+	case createModeAdopt:
+		existing := plan
+		r.updateModelFromAPI(&existing, &getResp.PasswordStrengthConfig)
+		if conflicts := adoptModel(&plan, config, existing); len(conflicts) > 0 {
+			addAdoptConflictError(&resp.Diagnostics, "password config", conflicts)
+			return
+		}
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+	}
+}
+
+// nonDefaultValues returns the attributes of config, the environment's password config, that
+// differ from the Stytch defaults.
+func (r *passwordConfigResource) nonDefaultValues(
+	model passwordConfigModel, config *passwordstrengthconfig.PasswordStrengthConfig,
+) []attributeDifference {
+	defaults := DefaultPasswordConfig(model.ProjectSlug.ValueString(), model.EnvironmentSlug.ValueString())
+	defaultModel := model
+	r.updateModelFromAPI(&defaultModel, &passwordstrengthconfig.PasswordStrengthConfig{
+		CheckBreachOnCreation:       defaults.CheckBreachOnCreation,
+		CheckBreachOnAuthentication: defaults.CheckBreachOnAuthentication,
+		ValidateOnAuthentication:    defaults.ValidateOnAuthentication,
+		ValidationPolicy:            defaults.ValidationPolicy,
+		LudsMinPasswordLength:       defaults.LudsMinPasswordLength,
+		LudsMinPasswordComplexity:   defaults.LudsMinPasswordComplexity,
+	})
+	existingModel := model
+	r.updateModelFromAPI(&existingModel, config)
+	return compareModels(existingModel, defaultModel)
+}
+
 // updateModelFromAPI updates the model with values from the API response.
 func (r *passwordConfigResource) updateModelFromAPI(model *passwordConfigModel, config *passwordstrengthconfig.PasswordStrengthConfig) {
 	model.CheckBreachOnCreation = types.BoolValue(config.CheckBreachOnCreation)
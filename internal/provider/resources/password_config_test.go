@@ -1,10 +1,15 @@
 package resources_test
 
 import (
+	"context"
+	"fmt"
 	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/passwordstrengthconfig"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
 	"github.com/stytchauth/terraform-provider-stytch/internal/provider/testutil"
 )
 
@@ -187,3 +192,139 @@ resource "stytch_password_config" "test" {
 		Steps: testutil.StateUpgradeTestSteps(v1Config, v3Config),
 	})
 }
+
+func TestAccPasswordConfigResourceOnDestroyRestore(t *testing.T) {
+	projectSlug := "test-acc-password-config-on-destroy-restore"
+	projectConfig := testutil.ProviderConfig + testutil.ProjectResource(testutil.ProjectResourceArgs{
+		Name:               "test-consumer",
+		Vertical:           projects.VerticalConsumer,
+		ProjectSlug:        &projectSlug,
+		DeletionProtection: testutil.Unprotected(),
+	})
+
+	getConfig := func() (passwordstrengthconfig.PasswordStrengthConfig, error) {
+		getResp, err := testutil.Client().PasswordStrengthConfig.Get(context.Background(), passwordstrengthconfig.GetRequest{
+			ProjectSlug:     projectSlug,
+			EnvironmentSlug: "production",
+		})
+		if err != nil {
+			return passwordstrengthconfig.PasswordStrengthConfig{}, err
+		}
+		return getResp.PasswordStrengthConfig, nil
+	}
+
+	var original passwordstrengthconfig.PasswordStrengthConfig
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: projectConfig,
+				Check: func(*terraform.State) error {
+					var err error
+					original, err = getConfig()
+					return err
+				},
+			},
+			{
+				Config: projectConfig + `
+resource "stytch_password_config" "test" {
+  project_slug                 = stytch_project.test.project_slug
+  environment_slug             = stytch_project.test.live_environment.environment_slug
+  validation_policy            = "LUDS"
+  luds_min_password_length     = 20
+  luds_min_password_complexity = 4
+  on_destroy                   = "restore"
+}
+`,
+				Check: resource.TestCheckResourceAttr("stytch_password_config.test", "on_destroy", "restore"),
+			},
+			{
+				// Destroying the password config writes back the config from before it was created.
+				Config: projectConfig,
+				Check: func(*terraform.State) error {
+					restored, err := getConfig()
+					if err != nil {
+						return err
+					}
+					if restored.ValidationPolicy != original.ValidationPolicy {
+						return fmt.Errorf("expected validation policy %s to be restored, got %s",
+							original.ValidationPolicy, restored.ValidationPolicy)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccPasswordConfigResourceCreateMode(t *testing.T) {
+	projectSlug := "test-acc-password-config-create-mode"
+	projectConfig := testutil.ProviderConfig + testutil.ProjectResource(testutil.ProjectResourceArgs{
+		Name:               "test-consumer",
+		Vertical:           projects.VerticalConsumer,
+		ProjectSlug:        &projectSlug,
+		DeletionProtection: testutil.Unprotected(),
+	})
+
+	passwordConfig := func(createMode, validationPolicy string) string {
+		config := fmt.Sprintf(`
+resource "stytch_password_config" "test" {
+  project_slug      = stytch_project.test.project_slug
+  environment_slug  = stytch_project.test.live_environment.environment_slug
+  validation_policy = %q
+  create_mode       = %q
+`, validationPolicy, createMode)
+		if validationPolicy == "LUDS" {
+			config += `  luds_min_password_length     = 20
+  luds_min_password_complexity = 4
+`
+		}
+		return projectConfig + config + "}\n"
+	}
+
+	length, complexity := 20, 4
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: projectConfig,
+				Check: func(*terraform.State) error {
+					// Change the password config outside of Terraform.
+					_, err := testutil.Client().PasswordStrengthConfig.Set(context.Background(), passwordstrengthconfig.SetRequest{
+						ProjectSlug:                 projectSlug,
+						EnvironmentSlug:             "production",
+						CheckBreachOnCreation:       false,
+						CheckBreachOnAuthentication: true,
+						ValidateOnAuthentication:    true,
+						ValidationPolicy:            passwordstrengthconfig.ValidationPolicyLUDS,
+						LudsMinPasswordLength:       &length,
+						LudsMinPasswordComplexity:   &complexity,
+					})
+					return err
+				},
+			},
+			{
+				Config:      passwordConfig("require_default", "LUDS"),
+				ExpectError: regexp.MustCompile("The password config has non-default values"),
+			},
+			{
+				Config:      passwordConfig("adopt", "ZXCVBN"),
+				ExpectError: regexp.MustCompile("The password config can't be adopted"),
+			},
+			{
+				// The omitted check_breach_on_creation keeps its current value instead of the default.
+				Config: passwordConfig("adopt", "LUDS"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("stytch_password_config.test", "create_mode", "adopt"),
+					resource.TestCheckResourceAttr("stytch_password_config.test", "check_breach_on_creation", "false"),
+					resource.TestCheckResourceAttr("stytch_password_config.test", "luds_min_password_length", "20"),
+				),
+			},
+			{
+				// Later plans keep the adopted values of omitted attributes instead of planning their defaults.
+				Config:   passwordConfig("adopt", "LUDS"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
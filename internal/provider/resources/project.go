@@ -24,6 +24,8 @@ import (
 	"github.com/stytchauth/stytch-management-go/v3/pkg/models/environments"
 	migrationprojects "github.com/stytchauth/stytch-management-go/v3/pkg/models/migration/projects"
 	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/providerdata"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/tracing"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -31,7 +33,10 @@ var (
 	_ resource.Resource                 = &projectResource{}
 	_ resource.ResourceWithConfigure    = &projectResource{}
 	_ resource.ResourceWithImportState  = &projectResource{}
+	_ resource.ResourceWithModifyPlan   = &projectResource{}
 	_ resource.ResourceWithUpgradeState = &projectResource{}
+	_ resource.ResourceWithIdentity     = &projectResource{}
+	_ resource.ResourceWithMoveState    = &projectResource{}
 )
 
 func NewProjectResource() resource.Resource {
@@ -39,7 +44,9 @@ func NewProjectResource() resource.Resource {
 }
 
 type projectResource struct {
-	client *api.API
+	client     *api.API
+	readOnly   bool
+	protection *providerdata.Protection
 }
 
 type projectResourceModelV0 struct {
@@ -102,14 +109,19 @@ var environmentAttributeTypes = map[string]attr.Type{
 	"created_at": types.StringType,
 }
 
+// discoverLiveEnvironmentKey is the private state key that tells the first read of an imported
+// project to discover its live environment.
+const discoverLiveEnvironmentKey = "discover_live_environment"
+
 type projectModel struct {
-	ID              types.String `tfsdk:"id"`
-	ProjectSlug     types.String `tfsdk:"project_slug"`
-	Name            types.String `tfsdk:"name"`
-	Vertical        types.String `tfsdk:"vertical"`
-	LiveEnvironment types.Object `tfsdk:"live_environment"`
-	CreatedAt       types.String `tfsdk:"created_at"`
-	LastUpdated     types.String `tfsdk:"last_updated"`
+	ID                 types.String `tfsdk:"id"`
+	ProjectSlug        types.String `tfsdk:"project_slug"`
+	Name               types.String `tfsdk:"name"`
+	Vertical           types.String `tfsdk:"vertical"`
+	LiveEnvironment    types.Object `tfsdk:"live_environment"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	LastUpdated        types.String `tfsdk:"last_updated"`
 }
 
 func (r *projectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -117,16 +129,18 @@ func (r *projectResource) Configure(ctx context.Context, req resource.ConfigureR
 		return
 	}
 
-	client, ok := req.ProviderData.(*api.API)
+	data, ok := req.ProviderData.(*providerdata.ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *api.API (stytch-management-go client), got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.readOnly = data.ReadOnly
+	r.protection = data.Protection
 }
 
 func (r *projectResource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
@@ -239,6 +253,8 @@ func (r *projectResource) upgradeProjectStateV0ToV1(
 		CreatedAt:       types.StringValue(createdAt),
 		LastUpdated:     types.StringValue(lastUpdated),
 		LiveEnvironment: liveEnvObj,
+		// Projects created before deletion_protection existed aren't protected until an apply sets it.
+		DeletionProtection: types.BoolValue(false),
 	}
 
 	diags = resp.State.Set(ctx, newState)
@@ -252,7 +268,7 @@ func (r *projectResource) Metadata(_ context.Context, req resource.MetadataReque
 func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Version:     1,
-		Description: "Manages a Stytch project and its live environment.",
+		Description: "Manages a Stytch project and its live environment. A `moved` block can move a `stytch_environment` that manages the live environment into `live_environment`.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "A computed ID field used for Terraform resource management.",
@@ -291,6 +307,7 @@ func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"deletion_protection": deletionProtectionAttribute("project", projectDeletionProtectionDefault),
 			"last_updated": schema.StringAttribute{
 				Description: "Timestamp of the last Terraform update for the resource.",
 				Computed:    true,
@@ -328,7 +345,7 @@ func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 						},
 					},
 					"cross_org_passwords_enabled": schema.BoolAttribute{
-						Description: "Whether cross-org passwords are enabled for the environment. Irrelevant for Consumer projects.",
+						Description: "Whether cross-org passwords are enabled for the environment. Can only be configured for B2B projects.",
 						Optional:    true,
 						Computed:    true,
 						PlanModifiers: []planmodifier.Bool{
@@ -415,7 +432,42 @@ func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 	}
 }
 
+// projectIdentityAttributes are the attributes that identify a project, in the order of its import
+// ID.
+var projectIdentityAttributes = []string{"project_slug"}
+
+// IdentitySchema defines the identity schema for the resource.
+func (r *projectResource) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identitySchema(projectIdentityAttributes...)
+}
+
+// ModifyPlan checks B2B-only attributes of the live environment against the project's vertical
+// and guards the project's live environment if it is protected.
+func (r *projectResource) ModifyPlan(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+) {
+	checkPlannedVertical(ctx, "stytch_project", req, resp,
+		verticalAttribute{
+			path:     path.Root("live_environment").AtName("cross_org_passwords_enabled"),
+			vertical: projects.VerticalB2B,
+		},
+	)
+	liveEnvironmentSlug := path.Root("live_environment").AtName("environment_slug")
+	guardProtectedEnvironment(ctx, r.protection, liveEnvironmentSlug, true, req, resp,
+		path.Root("project_slug"), path.Root("vertical"), liveEnvironmentSlug)
+}
+
 func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_project", "Create", req.Plan)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, projectIdentityAttributes...)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "create this resource") {
+		return
+	}
+
 	var plan projectModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -491,6 +543,10 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 }
 
 func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_project", "Read", req.State)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, projectIdentityAttributes...)
+
 	var state projectModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -506,6 +562,9 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		ProjectSlug: state.ProjectSlug.ValueString(),
 	})
 	if err != nil {
+		if removeIfNotFound(ctx, err, resp) {
+			return
+		}
 		resp.Diagnostics.AddError("Failed to get project", err.Error())
 		return
 	}
@@ -518,10 +577,19 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 	state.Name = types.StringValue(getProjectResp.Project.Name)
 	state.Vertical = types.StringValue(string(getProjectResp.Project.Vertical))
 	state.CreatedAt = types.StringValue(getProjectResp.Project.CreatedAt.Format(time.RFC3339))
+	state.DeletionProtection = defaultDeletionProtection(state.DeletionProtection)
 
 	// Try to discover and read the live environment
 	// If state already has the environment slug, use it directly
-	// Otherwise, query all environments to find the LIVE one
+	// Otherwise, if the project was just imported, query all environments to find the LIVE one.
+	// Other projects without a live environment leave it to a LIVE stytch_environment, if any.
+	discover, diags := req.Private.GetKey(ctx, discoverLiveEnvironmentKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, discoverLiveEnvironmentKey, nil)...)
+
 	var environmentSlug string
 	if !state.LiveEnvironment.IsNull() && !state.LiveEnvironment.IsUnknown() {
 		var liveEnvState environmentModel
@@ -531,7 +599,7 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 			return
 		}
 		environmentSlug = liveEnvState.EnvironmentSlug.ValueString()
-	} else {
+	} else if len(discover) > 0 {
 		// Discover the live environment by listing all environments
 		tflog.Info(ctx, "Discovering live environment")
 		getAllEnvResp, err := r.client.Environments.GetAll(ctx, environments.GetAllRequest{
@@ -567,6 +635,17 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 		tflog.Info(ctx, "Read live environment")
 
+		// A stytch_environment moved into live_environment must be the project's live environment.
+		if getEnvResp.Environment.Type != environments.EnvironmentTypeLive {
+			resp.Diagnostics.AddError(
+				"Not a live environment",
+				fmt.Sprintf("Environment %q of project %q is a %s environment, but live_environment must be the "+
+					"project's live environment. Move it back to a stytch_environment resource.",
+					environmentSlug, state.ProjectSlug.ValueString(), getEnvResp.Environment.Type),
+			)
+			return
+		}
+
 		liveEnvState := refreshFromLiveEnv(getEnvResp.Environment)
 
 		liveEnvObj, diags := types.ObjectValueFrom(ctx, state.LiveEnvironment.AttributeTypes(ctx), liveEnvState)
@@ -582,6 +661,14 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 }
 
 func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_project", "Update", req.Plan)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, projectIdentityAttributes...)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "update this resource") {
+		return
+	}
+
 	var plan projectModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -612,6 +699,8 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	state.DeletionProtection = plan.DeletionProtection
+
 	// Update the project name if changed
 	if !plan.Name.Equal(state.Name) {
 		updateProjectReq := projects.UpdateRequest{
@@ -749,6 +838,13 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 }
 
 func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_project", "Delete", req.State)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "delete this resource") {
+		return
+	}
+
 	var state projectModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -759,6 +855,11 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
 	ctx = tflog.SetField(ctx, "project_slug", state.ProjectSlug.ValueString())
 	tflog.Info(ctx, "Deleting project")
 
+	if !checkDeletionProtection(&resp.Diagnostics, defaultDeletionProtection(state.DeletionProtection),
+		"project", state.ProjectSlug.ValueString()) {
+		return
+	}
+
 	_, err := r.client.Projects.Delete(ctx, projects.DeleteRequest{
 		ProjectSlug: state.ProjectSlug.ValueString(),
 	})
@@ -773,7 +874,75 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
 func (r *projectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	ctx = tflog.SetField(ctx, "project_slug", req.ID)
 	tflog.Info(ctx, "Importing project")
-	resource.ImportStatePassthroughID(ctx, path.Root("project_slug"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("project_slug"), path.Root("project_slug"), req, resp)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, discoverLiveEnvironmentKey, []byte("true"))...)
+}
+
+// MoveState moves a LIVE stytch_environment into the live_environment of its project's
+// stytch_project, for live environments that were managed on their own.
+func (r *projectResource) MoveState(ctx context.Context) []resource.StateMover {
+	var environmentSchema resource.SchemaResponse
+	(&environmentResource{}).Schema(ctx, resource.SchemaRequest{}, &environmentSchema)
+
+	return []resource.StateMover{
+		{
+			SourceSchema: &environmentSchema.Schema,
+			StateMover:   r.moveStateFromEnvironment,
+		},
+	}
+}
+
+func (r *projectResource) moveStateFromEnvironment(
+	ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse,
+) {
+	if req.SourceTypeName != "stytch_environment" || req.SourceState == nil {
+		return
+	}
+
+	var source environmentResourceModel
+	diags := req.SourceState.Get(ctx, &source)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// States written before the type attribute existed are checked when the project is refreshed.
+	if !source.Type.IsNull() && source.Type.ValueString() != string(environments.EnvironmentTypeLive) {
+		resp.Diagnostics.AddError(
+			"Unable to move environment",
+			fmt.Sprintf("Environment %s is a %s environment, but only the LIVE environment can be moved into live_environment.",
+				source.ID.ValueString(), source.Type.ValueString()),
+		)
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "project_slug", source.ProjectSlug.ValueString())
+	ctx = tflog.SetField(ctx, "environment_slug", source.EnvironmentSlug.ValueString())
+	tflog.Info(ctx, "Moving environment into project live environment")
+
+	liveEnvObj, diags := types.ObjectValueFrom(ctx, environmentAttributeTypes, liveEnvironmentFromEnvironment(source))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The project's own attributes aren't part of the environment's state. They are read when the
+	// moved resource is refreshed, which also checks that the environment is the live one. The
+	// environment's deletion protection doesn't carry over, since the defaults of the two resources
+	// differ.
+	target := projectModel{
+		ID:                 source.ProjectSlug,
+		ProjectSlug:        source.ProjectSlug,
+		Name:               types.StringNull(),
+		Vertical:           types.StringNull(),
+		LiveEnvironment:    liveEnvObj,
+		CreatedAt:          types.StringNull(),
+		DeletionProtection: types.BoolValue(projectDeletionProtectionDefault),
+		LastUpdated:        source.LastUpdated,
+	}
+	diags = resp.TargetState.Set(ctx, target)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, &resp.TargetState, resp.TargetIdentity, &resp.Diagnostics, projectIdentityAttributes...)
 }
 
 // Helper method to build the environment model from API response.
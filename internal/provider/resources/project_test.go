@@ -7,6 +7,8 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
 	"github.com/stytchauth/terraform-provider-stytch/internal/provider/testutil"
 )
@@ -30,6 +32,7 @@ func TestAccProjectResource(t *testing.T) {
 							ProjectSlug:         &projectSlug,
 							Vertical:            vertical,
 							LiveEnvironmentName: &prodEnv,
+							DeletionProtection:  testutil.Unprotected(),
 						}),
 						Check: resource.ComposeAggregateTestCheckFunc(
 							resource.TestCheckResourceAttr("stytch_project.test", "name", "AccProjectResource"),
@@ -57,6 +60,7 @@ func TestAccProjectResource(t *testing.T) {
 							Name:                "test2",
 							Vertical:            vertical,
 							LiveEnvironmentName: strPtr("Live Environment"),
+							DeletionProtection:  testutil.Unprotected(),
 						}),
 						Check: resource.ComposeAggregateTestCheckFunc(
 							resource.TestCheckResourceAttr("stytch_project.test", "name", "test2"),
@@ -96,6 +100,7 @@ func TestAccProjectResourceWithEnvironmentConfig(t *testing.T) {
 					UserLockTTL:                  &ttl,
 					IdpAuthorizationURL:          &idpAuthURL,
 					IdpDCREnabled:                &trueVal,
+					DeletionProtection:           testutil.Unprotected(),
 				}),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("stytch_project.test", "live_environment.cross_org_passwords_enabled", "true"),
@@ -119,6 +124,7 @@ func TestAccProjectResourceWithEnvironmentConfig(t *testing.T) {
 					UserLockSelfServeEnabled: &falseVal,
 					UserLockThreshold:        &threshold,
 					UserLockTTL:              &ttl,
+					DeletionProtection:       testutil.Unprotected(),
 				}),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("stytch_project.test", "live_environment.name", "Production Updated"),
@@ -139,8 +145,9 @@ func TestAccProjectResourceWithoutLiveEnvironment(t *testing.T) {
 				// Create project without live environment
 				Config: testutil.ProviderConfig + `
 resource "stytch_project" "test" {
-  name     = "Test Without Live Env"
-  vertical = "CONSUMER"
+  name                = "Test Without Live Env"
+  vertical            = "CONSUMER"
+  deletion_protection = false
 }`,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("stytch_project.test", "name", "Test Without Live Env"),
@@ -154,6 +161,7 @@ resource "stytch_project" "test" {
 					Name:                "Test Without Live Env",
 					Vertical:            projects.VerticalConsumer,
 					LiveEnvironmentName: strPtr("Production"),
+					DeletionProtection:  testutil.Unprotected(),
 				}),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("stytch_project.test", "name", "Test Without Live Env"),
@@ -175,6 +183,7 @@ func TestAccProjectResourceCannotRemoveLiveEnvironment(t *testing.T) {
 					Name:                "Test Removal",
 					Vertical:            projects.VerticalConsumer,
 					LiveEnvironmentName: strPtr("Production"),
+					DeletionProtection:  testutil.Unprotected(),
 				}),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("stytch_project.test", "live_environment.name", "Production"),
@@ -184,8 +193,9 @@ func TestAccProjectResourceCannotRemoveLiveEnvironment(t *testing.T) {
 				// Try to remove live environment - should fail
 				Config: testutil.ProviderConfig + `
 resource "stytch_project" "test" {
-  name     = "Test Removal"
-  vertical = "CONSUMER"
+  name                = "Test Removal"
+  vertical            = "CONSUMER"
+  deletion_protection = false
 }`,
 				ExpectError: regexp.MustCompile("Cannot remove live_environment"),
 			},
@@ -204,8 +214,9 @@ resource "stytch_project" "test" {
 
 	v3Config := `
 resource "stytch_project" "test" {
-  name     = "State Upgrade Test"
-  vertical = "CONSUMER"
+  name                = "State Upgrade Test"
+  vertical            = "CONSUMER"
+  deletion_protection = false
   live_environment = {
     name = "Production"
     user_impersonation_enabled = true
@@ -217,3 +228,154 @@ resource "stytch_project" "test" {
 		Steps: testutil.StateUpgradeTestSteps(v1Config, v3Config),
 	})
 }
+
+func TestAccProjectResourceVertical(t *testing.T) {
+	trueVal := true
+	errorCase := testutil.ErrorCase{
+		Name: "consumer project with cross-org passwords",
+		Config: testutil.ProjectResource(testutil.ProjectResourceArgs{
+			Name:                     "AccProjectResourceVertical",
+			Vertical:                 projects.VerticalConsumer,
+			CrossOrgPasswordsEnabled: &trueVal,
+			DeletionProtection:       testutil.Unprotected(),
+		}),
+	}
+	errorCase.AssertErrorWith(t, regexp.MustCompile(`can only be configured for B2B projects`))
+}
+
+func TestAccProjectResourceReadOnlyProvider(t *testing.T) {
+	readOnlyProviderConfig := `
+provider "stytch" {
+  read_only = true
+}
+`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Planning still works in read-only mode.
+				Config:             readOnlyProviderConfig + testutil.ConsumerProjectConfig,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// Applying is refused before the Stytch API is contacted.
+				Config:      readOnlyProviderConfig + testutil.ConsumerProjectConfig,
+				ExpectError: regexp.MustCompile(`Provider is read-only`),
+			},
+		},
+	})
+}
+
+func TestAccProjectResourceMoveState(t *testing.T) {
+	projectSlug := "test-acc-project-resource-move-state"
+	// The project keeps its default deletion protection, which differs from the environment's.
+	projectArgs := testutil.ProjectResourceArgs{
+		Name:        "AccProjectResourceMoveState",
+		ProjectSlug: &projectSlug,
+		Vertical:    projects.VerticalConsumer,
+	}
+	projectConfig := testutil.ProviderConfig + testutil.ProjectResource(projectArgs)
+	projectArgs.DeletionProtection = testutil.Unprotected()
+	unprotectedProjectConfig := testutil.ProviderConfig + testutil.ProjectResource(projectArgs)
+	environmentConfig := testutil.ProviderConfig + fmt.Sprintf(`
+resource "stytch_environment" "live" {
+  project_slug     = "%s"
+  environment_slug = "production"
+  name             = "Production"
+}
+`, projectSlug)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: projectConfig,
+			},
+			{
+				// Extract the live environment into its own resource.
+				Config: environmentConfig + `
+moved {
+  from = stytch_project.test
+  to   = stytch_environment.live
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("stytch_environment.live", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("stytch_environment.live", "id", projectSlug+".production"),
+					resource.TestCheckResourceAttr("stytch_environment.live", "type", "LIVE"),
+					resource.TestCheckResourceAttr("stytch_environment.live", "deletion_protection", "false"),
+					resource.TestCheckResourceAttr("stytch_environment.live", "project_slug", projectSlug),
+					resource.TestCheckResourceAttrSet("stytch_environment.live", "oauth_callback_id"),
+				),
+			},
+			{
+				// Move it back into the project.
+				Config: projectConfig + `
+moved {
+  from = stytch_environment.live
+  to   = stytch_project.test
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("stytch_project.test", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("stytch_project.test", "name", "AccProjectResourceMoveState"),
+					resource.TestCheckResourceAttr("stytch_project.test", "vertical", string(projects.VerticalConsumer)),
+					resource.TestCheckResourceAttr("stytch_project.test", "live_environment.environment_slug", "production"),
+					resource.TestCheckResourceAttr("stytch_project.test", "deletion_protection", "true"),
+				),
+			},
+			{
+				// Disabling deletion protection lets the project be destroyed at the end of the test.
+				Config: unprotectedProjectConfig,
+			},
+		},
+	})
+}
+
+func TestAccProjectResourceDeletionProtection(t *testing.T) {
+	// Projects are protected when deletion_protection is omitted.
+	protectedConfig := testutil.ProviderConfig + testutil.ProjectResource(testutil.ProjectResourceArgs{
+		Name:     "AccProjectResourceDeletionProtection",
+		Vertical: projects.VerticalConsumer,
+	})
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: protectedConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("stytch_project.test", "deletion_protection", "true"),
+				),
+			},
+			{
+				// Destroying a protected project fails.
+				Config:      protectedConfig,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`Deletion protection is enabled`),
+			},
+			{
+				// Disabling deletion protection lets the project be destroyed at the end of the test.
+				Config: testutil.ProviderConfig + testutil.ProjectResource(testutil.ProjectResourceArgs{
+					Name:               "AccProjectResourceDeletionProtection",
+					Vertical:           projects.VerticalConsumer,
+					DeletionProtection: testutil.Unprotected(),
+				}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("stytch_project.test", "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
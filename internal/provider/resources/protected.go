@@ -0,0 +1,129 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/providerdata"
+)
+
+// guardProtectedEnvironment warns about a planned change to a resource in a protected environment,
+// and fails the plan if the change destroys or replaces the resource, unless the provider allows
+// protected changes. environmentSlugPath is the attribute holding the slug of the environment the
+// resource belongs to, and knownLive tells that it is a live environment. replaceAttributes are the
+// attributes that replace the resource when they change, which must include every attribute with a
+// RequiresReplace plan modifier since those aren't in resp.RequiresReplace. It must be called after the plan has
+// been otherwise modified.
+func guardProtectedEnvironment(
+	ctx context.Context, protection *providerdata.Protection, environmentSlugPath path.Path, knownLive bool,
+	req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, replaceAttributes ...path.Path,
+) {
+	if !protection.Enabled() || resp.Diagnostics.HasError() {
+		return
+	}
+
+	var change string
+	var destructive bool
+	switch {
+	case req.Plan.Raw.IsNull():
+		change, destructive = "destroys", true
+	case req.State.Raw.IsNull():
+		change = "creates"
+	case requiresReplacement(ctx, req, resp, replaceAttributes):
+		change, destructive = "replaces", true
+	case resp.Plan.Raw.Equal(req.State.Raw):
+		// Nothing changes.
+		return
+	default:
+		change = "updates"
+	}
+
+	// A destroyed or replaced resource is deleted from the environment in its state, which is
+	// always known.
+	var stateProjectSlug, stateEnvironmentSlug types.String
+	if destructive {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("project_slug"), &stateProjectSlug)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, environmentSlugPath, &stateEnvironmentSlug)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		checkProtectedEnvironment(ctx, protection, stateProjectSlug, stateEnvironmentSlug, knownLive, change,
+			!protection.AllowChanges, resp)
+		if resp.Diagnostics.HasError() || req.Plan.Raw.IsNull() {
+			return
+		}
+	}
+
+	// A created, updated or replaced resource is written to the environment in its plan.
+	var projectSlug, environmentSlug types.String
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("project_slug"), &projectSlug)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, environmentSlugPath, &environmentSlug)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// The environment in the state was already checked.
+	if destructive && projectSlug.Equal(stateProjectSlug) && environmentSlug.Equal(stateEnvironmentSlug) {
+		return
+	}
+	checkProtectedEnvironment(ctx, protection, projectSlug, environmentSlug, knownLive, change, false, resp)
+}
+
+// checkProtectedEnvironment adds an error to resp if fail is set and the environment is protected,
+// or a warning if it is protected and fail isn't set. change describes the planned change to the
+// resource. Writing to an environment that isn't known yet is never destructive, so nothing is
+// checked then, and it isn't checked again when the plan is applied.
+func checkProtectedEnvironment(
+	ctx context.Context, protection *providerdata.Protection, projectSlug, environmentSlug types.String,
+	knownLive bool, change string, fail bool, resp *resource.ModifyPlanResponse,
+) {
+	if projectSlug.IsUnknown() || environmentSlug.IsUnknown() || environmentSlug.IsNull() {
+		return
+	}
+
+	environment := projectSlug.ValueString() + "." + environmentSlug.ValueString()
+	protected, err := protection.IsProtected(ctx, projectSlug.ValueString(), environmentSlug.ValueString(), knownLive)
+	if err != nil {
+		// If we can't tell, err on the side of caution.
+		resp.Diagnostics.AddWarning(
+			"Failed to check whether the environment is protected",
+			fmt.Sprintf("Treating environment %s as protected: %s", environment, err.Error()),
+		)
+		protected = true
+	}
+	if !protected {
+		return
+	}
+
+	detail := fmt.Sprintf("This plan %s a resource in the protected environment %s.", change, environment)
+	if fail {
+		resp.Diagnostics.AddError(
+			"Change to protected environment",
+			detail+" Destroying or replacing resources in a protected environment requires the provider's "+
+				"allow_protected_changes attribute or the STYTCH_ALLOW_PROTECTED_CHANGES environment variable to be set.",
+		)
+		return
+	}
+	resp.Diagnostics.AddWarning("Change to protected environment", detail)
+}
+
+// requiresReplacement reports whether the planned update replaces the resource, either because one
+// of the replaceAttributes changes or because the resource's ModifyPlan already requires it.
+func requiresReplacement(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, replaceAttributes []path.Path,
+) bool {
+	if len(resp.RequiresReplace) > 0 {
+		return true
+	}
+	for _, attrPath := range replaceAttributes {
+		var planValue, stateValue types.String
+		resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, attrPath, &planValue)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, attrPath, &stateValue)...)
+		if !planValue.Equal(stateValue) {
+			return true
+		}
+	}
+	return false
+}
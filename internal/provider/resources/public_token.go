@@ -2,19 +2,27 @@ package resources
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
-	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
 	"github.com/stytchauth/stytch-management-go/v3/pkg/models/publictokens"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/providerdata"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/tracing"
 	"github.com/stytchauth/terraform-provider-stytch/internal/provider/utils"
 )
 
@@ -24,6 +32,8 @@ var (
 	_ resource.ResourceWithConfigure    = &publicTokenResource{}
 	_ resource.ResourceWithImportState  = &publicTokenResource{}
 	_ resource.ResourceWithUpgradeState = &publicTokenResource{}
+	_ resource.ResourceWithModifyPlan   = &publicTokenResource{}
+	_ resource.ResourceWithIdentity     = &publicTokenResource{}
 )
 
 func NewPublicTokenResource() resource.Resource {
@@ -31,15 +41,70 @@ func NewPublicTokenResource() resource.Resource {
 }
 
 type publicTokenResource struct {
-	client *api.API
+	client     *api.API
+	defaults   providerdata.Defaults
+	readOnly   bool
+	protection *providerdata.Protection
 }
 
 type publicTokenModel struct {
-	ID              types.String `tfsdk:"id"`
-	ProjectSlug     types.String `tfsdk:"project_slug"`
-	EnvironmentSlug types.String `tfsdk:"environment_slug"`
-	PublicToken     types.String `tfsdk:"public_token"`
-	CreatedAt       types.String `tfsdk:"created_at"`
+	ID                   types.String `tfsdk:"id"`
+	ProjectSlug          types.String `tfsdk:"project_slug"`
+	EnvironmentSlug      types.String `tfsdk:"environment_slug"`
+	PublicToken          types.String `tfsdk:"public_token"`
+	CreatedAt            types.String `tfsdk:"created_at"`
+	RotationTrigger      types.String `tfsdk:"rotation_trigger"`
+	RetainPrevious       types.Int64  `tfsdk:"retain_previous"`
+	PreviousPublicTokens types.List   `tfsdk:"previous_public_tokens"`
+}
+
+// previousPublicTokens returns the previous public tokens tracked in the model, newest first.
+func (m publicTokenModel) previousPublicTokens(ctx context.Context) ([]string, diag.Diagnostics) {
+	previous := []string{}
+	if m.PreviousPublicTokens.IsNull() || m.PreviousPublicTokens.IsUnknown() {
+		return previous, nil
+	}
+	diags := m.PreviousPublicTokens.ElementsAs(ctx, &previous, false)
+	return previous, diags
+}
+
+// previousPublicTokenAppliesKey is the private state key of the number of applies each previous
+// public token has been retained for since its rotation, keyed by token.
+const previousPublicTokenAppliesKey = "previous_public_token_applies"
+
+// loadPreviousPublicTokenApplies returns the number of applies each previous public token has been
+// retained for. Tokens retained by earlier versions of the provider haven't been counted.
+func loadPreviousPublicTokenApplies(
+	ctx context.Context, private privateStateGetter, diags *diag.Diagnostics,
+) map[string]int64 {
+	applies := map[string]int64{}
+	value, getDiags := private.GetKey(ctx, previousPublicTokenAppliesKey)
+	diags.Append(getDiags...)
+	if diags.HasError() || len(value) == 0 {
+		return applies
+	}
+	if err := json.Unmarshal(value, &applies); err != nil {
+		diags.AddError("Failed to load the applies of previous public tokens", err.Error())
+	}
+	return applies
+}
+
+// savePreviousPublicTokenApplies saves the number of applies each of the previous public tokens has
+// been retained for.
+func savePreviousPublicTokenApplies(
+	ctx context.Context, private privateStateSetter, previous []string, applies map[string]int64,
+	diags *diag.Diagnostics,
+) {
+	retained := make(map[string]int64, len(previous))
+	for _, token := range previous {
+		retained[token] = applies[token]
+	}
+	value, err := json.Marshal(retained)
+	if err != nil {
+		diags.AddError("Failed to save the applies of previous public tokens", err.Error())
+		return
+	}
+	diags.Append(private.SetKey(ctx, previousPublicTokenAppliesKey, value)...)
 }
 
 type publicTokenResourceModelV0 struct {
@@ -71,18 +136,21 @@ func (r *publicTokenResource) Configure(
 		return
 	}
 
-	client, ok := req.ProviderData.(*api.API)
+	data, ok := req.ProviderData.(*providerdata.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *api.API (stytch-management-go client), got: %T. Please report "+
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report "+
 				"this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.defaults = data.Defaults
+	r.readOnly = data.ReadOnly
+	r.protection = data.Protection
 }
 
 func (r *publicTokenResource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
@@ -150,11 +218,14 @@ func (r *publicTokenResource) upgradePublicTokenStateV0ToV1(
 	}
 
 	newState := publicTokenModel{
-		ID:              types.StringValue(fmt.Sprintf("%s.%s.%s", projectSlug, environmentSlug, publicTokenValue)),
-		ProjectSlug:     types.StringValue(projectSlug),
-		EnvironmentSlug: types.StringValue(environmentSlug),
-		PublicToken:     types.StringValue(publicTokenValue),
-		CreatedAt:       types.StringValue(createdAt),
+		ID:                   types.StringValue(utils.FormatID(projectSlug, environmentSlug, publicTokenValue)),
+		ProjectSlug:          types.StringValue(projectSlug),
+		EnvironmentSlug:      types.StringValue(environmentSlug),
+		PublicToken:          types.StringValue(publicTokenValue),
+		CreatedAt:            types.StringValue(createdAt),
+		RotationTrigger:      types.StringNull(),
+		RetainPrevious:       types.Int64Value(0),
+		PreviousPublicTokens: types.ListValueMust(types.StringType, []attr.Value{}),
 	}
 
 	diags = resp.State.Set(ctx, newState)
@@ -166,6 +237,8 @@ func (r *publicTokenResource) Metadata(
 	_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse,
 ) {
 	resp.TypeName = req.ProviderTypeName + "_public_token"
+	// Rotating the token changes the public_token attribute of the identity.
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 // Schema defines the schema for the resource.
@@ -191,17 +264,19 @@ func (r *publicTokenResource) Schema(
 				},
 			},
 			"project_slug": schema.StringAttribute{
-				Required:    true,
-				Description: "The slug of the project to which the public token belongs.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The slug of the project to which the public token belongs. Defaults to the provider's `default_project_slug`.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"environment_slug": schema.StringAttribute{
-				Required:    true,
-				Description: "The slug of the environment to which the public token belongs.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The slug of the environment to which the public token belongs. Defaults to the provider's `default_environment_slug`.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"created_at": schema.StringAttribute{
@@ -211,14 +286,97 @@ func (r *publicTokenResource) Schema(
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"rotation_trigger": schema.StringAttribute{
+				Optional: true,
+				Description: "An arbitrary value that rotates the public token whenever it changes, such as a release " +
+					"version. The new token is created before the current one is retired, so clients embedding the " +
+					"current token keep working while they are rolled out with the new one.",
+			},
+			"retain_previous": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(0),
+				Description: "The number of applies to keep a previous public token active for after a rotation. The " +
+					"previous token is deleted once this resource has been applied this many times since the rotation, " +
+					"and every plan updates this resource while previous tokens are retained so that its applies are " +
+					"counted. Defaults to 0, which deletes the previous token as soon as its replacement has been created.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"previous_public_tokens": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "The previous public tokens that are still active, ordered from newest to oldest.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
 
+// publicTokenIdentityAttributes are the attributes that identify a public token, in the order of
+// its import ID.
+var publicTokenIdentityAttributes = []string{"project_slug", "environment_slug", "public_token"}
+
+// IdentitySchema defines the identity schema for the resource.
+func (r *publicTokenResource) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identitySchema(publicTokenIdentityAttributes...)
+}
+
+// ModifyPlan plans the provider's default project and environment slugs for the ones omitted from
+// the configuration and guards protected environments. It also marks the token attributes as
+// unknown when the plan rotates the public token or retains previous tokens, since their new values
+// are only known after the update.
+func (r *publicTokenResource) ModifyPlan(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+) {
+	planDefaultProjectSlug(ctx, r.defaults, true, req, resp)
+	planDefaultEnvironmentSlug(ctx, r.defaults, true, req, resp)
+	guardProtectedEnvironment(ctx, r.protection, path.Root("environment_slug"), false, req, resp,
+		path.Root("project_slug"), path.Root("environment_slug"))
+
+	// Nothing else to do on create or destroy.
+	if resp.Diagnostics.HasError() || req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state publicTokenModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.RotationTrigger.Equal(state.RotationTrigger) {
+		plan.ID = types.StringUnknown()
+		plan.PublicToken = types.StringUnknown()
+		plan.CreatedAt = types.StringUnknown()
+		plan.PreviousPublicTokens = types.ListUnknown(types.StringType)
+	} else if len(state.PreviousPublicTokens.Elements()) > 0 {
+		// Every apply counts towards the applies previous tokens are retained for, so the resource
+		// is updated on every apply until they are deleted.
+		plan.PreviousPublicTokens = types.ListUnknown(types.StringType)
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *publicTokenResource) Create(
 	ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse,
 ) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_public_token", "Create", req.Plan)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, publicTokenIdentityAttributes...)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "create this resource") {
+		return
+	}
+
 	var plan publicTokenModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -242,9 +400,10 @@ func (r *publicTokenResource) Create(
 	ctx = tflog.SetField(ctx, "public_token", createResp.PublicToken.PublicToken)
 	tflog.Info(ctx, "Created public token")
 
-	plan.ID = types.StringValue(fmt.Sprintf("%s.%s.%s", plan.ProjectSlug.ValueString(), plan.EnvironmentSlug.ValueString(), createResp.PublicToken.PublicToken))
+	plan.ID = types.StringValue(utils.FormatID(plan.ProjectSlug.ValueString(), plan.EnvironmentSlug.ValueString(), createResp.PublicToken.PublicToken))
 	plan.PublicToken = types.StringValue(createResp.PublicToken.PublicToken)
 	plan.CreatedAt = types.StringValue(createResp.PublicToken.CreatedAt.Format(time.RFC3339))
+	plan.PreviousPublicTokens = types.ListValueMust(types.StringType, []attr.Value{})
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -256,6 +415,10 @@ func (r *publicTokenResource) Create(
 func (r *publicTokenResource) Read(
 	ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse,
 ) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_public_token", "Read", req.State)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, publicTokenIdentityAttributes...)
+
 	// Get the current state
 	var state publicTokenModel
 	diags := req.State.Get(ctx, &state)
@@ -276,12 +439,55 @@ func (r *publicTokenResource) Read(
 		PublicToken:     state.PublicToken.ValueString(),
 	})
 	if err != nil {
+		if removeIfNotFound(ctx, err, resp) {
+			return
+		}
 		resp.Diagnostics.AddError("Failed to get public token", err.Error())
 		return
 	}
 
+	// Drop any previous tokens that have since been deleted outside of Terraform.
+	previous, diags := state.previousPublicTokens(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(previous) > 0 {
+		getAllResp, err := r.client.PublicTokens.GetAll(ctx, publictokens.GetAllRequest{
+			ProjectSlug:     state.ProjectSlug.ValueString(),
+			EnvironmentSlug: state.EnvironmentSlug.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to get public tokens", err.Error())
+			return
+		}
+
+		active := make(map[string]bool, len(getAllResp.PublicTokens))
+		for _, token := range getAllResp.PublicTokens {
+			active[token.PublicToken] = true
+		}
+
+		remaining := []string{}
+		for _, token := range previous {
+			if active[token] {
+				remaining = append(remaining, token)
+			}
+		}
+		previous = remaining
+	}
+
 	tflog.Info(ctx, "Read public token")
 
+	if state.RetainPrevious.IsNull() {
+		state.RetainPrevious = types.Int64Value(0)
+	}
+	state.PreviousPublicTokens, diags = types.ListValueFrom(ctx, types.StringType, previous)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -291,18 +497,132 @@ func (r *publicTokenResource) Read(
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *publicTokenResource) Update(
-	_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse,
+	ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse,
 ) {
-	resp.Diagnostics.AddError("Update not allowed",
-		"Updating this resource is not supported. Please delete and recreate the resource.")
-	//nolint:staticcheck
-	return // Needed so that the Semgrep rule can enforce AddError followed by return.
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_public_token", "Update", req.Plan)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, publicTokenIdentityAttributes...)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "update this resource") {
+		return
+	}
+
+	var plan, state publicTokenModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "project_slug", plan.ProjectSlug.ValueString())
+	ctx = tflog.SetField(ctx, "environment_slug", plan.EnvironmentSlug.ValueString())
+	ctx = tflog.SetField(ctx, "public_token", state.PublicToken.ValueString())
+	tflog.Info(ctx, "Updating public token")
+
+	previous, diags := state.previousPublicTokens(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applies := loadPreviousPublicTokenApplies(ctx, req.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// This apply counts for every token that was already retained.
+	for _, token := range previous {
+		applies[token]++
+	}
+
+	plan.ID = state.ID
+	plan.PublicToken = state.PublicToken
+	plan.CreatedAt = state.CreatedAt
+
+	if !plan.RotationTrigger.Equal(state.RotationTrigger) {
+		tflog.Info(ctx, "Rotating public token")
+
+		// Create the new token before retiring the current one so that there is always an active
+		// token for clients to use.
+		createResp, err := r.client.PublicTokens.Create(ctx, publictokens.CreateRequest{
+			ProjectSlug:     plan.ProjectSlug.ValueString(),
+			EnvironmentSlug: plan.EnvironmentSlug.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to create public token", err.Error())
+			return
+		}
+
+		previous = append([]string{state.PublicToken.ValueString()}, previous...)
+		applies[state.PublicToken.ValueString()] = 0
+		plan.ID = types.StringValue(utils.FormatID(plan.ProjectSlug.ValueString(), plan.EnvironmentSlug.ValueString(), createResp.PublicToken.PublicToken))
+		plan.PublicToken = types.StringValue(createResp.PublicToken.PublicToken)
+		plan.CreatedAt = types.StringValue(createResp.PublicToken.CreatedAt.Format(time.RFC3339))
+
+		ctx = tflog.SetField(ctx, "public_token", createResp.PublicToken.PublicToken)
+		tflog.Info(ctx, "Rotated public token")
+	}
+
+	// Delete the previous tokens that have been retained for retain_previous applies.
+	retained := make([]string, 0, len(previous))
+	for i, token := range previous {
+		if applies[token] < plan.RetainPrevious.ValueInt64() {
+			retained = append(retained, token)
+			continue
+		}
+
+		tflog.Info(ctx, "Deleting previous public token", map[string]interface{}{
+			"previous_public_token": token,
+		})
+
+		_, err := r.client.PublicTokens.Delete(ctx, publictokens.DeleteRequest{
+			ProjectSlug:     plan.ProjectSlug.ValueString(),
+			EnvironmentSlug: plan.EnvironmentSlug.ValueString(),
+			PublicToken:     token,
+		})
+		if err != nil {
+			// Save the progress made so far so that a newly created token is not lost.
+			retained = append(retained, previous[i:]...)
+			plan.PreviousPublicTokens, diags = types.ListValueFrom(ctx, types.StringType, retained)
+			resp.Diagnostics.Append(diags...)
+			savePreviousPublicTokenApplies(ctx, resp.Private, retained, applies, &resp.Diagnostics)
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			resp.Diagnostics.AddError("Failed to delete previous public token", err.Error())
+			return
+		}
+	}
+	previous = retained
+
+	tflog.Info(ctx, "Updated public token")
+
+	savePreviousPublicTokenApplies(ctx, resp.Private, previous, applies, &resp.Diagnostics)
+
+	plan.PreviousPublicTokens, diags = types.ListValueFrom(ctx, types.StringType, previous)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *publicTokenResource) Delete(
 	ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse,
 ) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_public_token", "Delete", req.State)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "delete this resource") {
+		return
+	}
+
 	var state publicTokenModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -315,6 +635,25 @@ func (r *publicTokenResource) Delete(
 	ctx = tflog.SetField(ctx, "public_token", state.PublicToken.ValueString())
 	tflog.Info(ctx, "Deleting public token")
 
+	previous, diags := state.previousPublicTokens(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete any previous tokens that are still being retained before the current one.
+	for _, token := range previous {
+		_, err := r.client.PublicTokens.Delete(ctx, publictokens.DeleteRequest{
+			ProjectSlug:     state.ProjectSlug.ValueString(),
+			EnvironmentSlug: state.EnvironmentSlug.ValueString(),
+			PublicToken:     token,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to delete previous public token", err.Error())
+			return
+		}
+	}
+
 	_, err := r.client.PublicTokens.Delete(ctx, publictokens.DeleteRequest{
 		ProjectSlug:     state.ProjectSlug.ValueString(),
 		EnvironmentSlug: state.EnvironmentSlug.ValueString(),
@@ -336,16 +675,12 @@ func (r *publicTokenResource) ImportState(
 	tflog.Info(ctx, "Importing public token")
 
 	// Import ID format: project_slug.environment_slug.public_token
-	parts := strings.Split(req.ID, ".")
-	if len(parts) != 3 {
-		resp.Diagnostics.AddError(
-			"Invalid import ID format",
-			fmt.Sprintf("Expected import ID format: project_slug.environment_slug.public_token, got: %s", req.ID),
-		)
+	parts, ok := importParts(ctx, req, &resp.Diagnostics, publicTokenIdentityAttributes...)
+	if !ok {
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), utils.FormatID(parts...))...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_slug"), parts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_slug"), parts[1])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("public_token"), parts[2])...)
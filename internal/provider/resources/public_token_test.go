@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -58,6 +59,96 @@ func TestAccPublicTokenResource(t *testing.T) {
 	}
 }
 
+func TestAccPublicTokenResourceRotation(t *testing.T) {
+	const resourceName = "stytch_public_token.test"
+
+	config := func(rotationTrigger string, retainPrevious int) string {
+		return testutil.ProviderConfig + testutil.ConsumerProjectConfig + testutil.EnvironmentResource(testutil.EnvironmentResourceArgs{
+			ProjectSlug: "stytch_project.test.project_slug",
+			Name:        "Test Environment",
+		}) + fmt.Sprintf(`
+      resource "stytch_public_token" "test" {
+        project_slug     = stytch_project.test.project_slug
+        environment_slug = stytch_environment.test.environment_slug
+        rotation_trigger = "%s"
+        retain_previous  = %d
+      }
+
+      # Create a second public token to ensure we can delete the rotated one
+      # (API prevents deleting the last public token for an environment)
+      resource "stytch_public_token" "test2" {
+        project_slug     = stytch_project.test.project_slug
+        environment_slug = stytch_environment.test.environment_slug
+      }`, rotationTrigger, retainPrevious)
+	}
+
+	var firstToken, secondToken string
+	captureToken := func(token *string) resource.TestCheckFunc {
+		return resource.TestCheckResourceAttrWith(resourceName, "public_token", func(value string) error {
+			*token = value
+			return nil
+		})
+	}
+	checkPreviousToken := func(index int, token *string) resource.TestCheckFunc {
+		return resource.TestCheckResourceAttrWith(resourceName, fmt.Sprintf("previous_public_tokens.%d", index), func(value string) error {
+			if value != *token {
+				return fmt.Errorf("expected previous public token %s, got %s", *token, value)
+			}
+			return nil
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("v1", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					captureToken(&firstToken),
+					resource.TestCheckResourceAttr(resourceName, "previous_public_tokens.#", "0"),
+				),
+			},
+			{
+				// Rotating keeps the previous token alive, and every later plan counts an apply.
+				Config: config("v2", 1),
+				Check: resource.ComposeTestCheckFunc(
+					captureToken(&secondToken),
+					resource.TestCheckResourceAttr(resourceName, "previous_public_tokens.#", "1"),
+					checkPreviousToken(0, &firstToken),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// The next apply deletes the token retained for one apply.
+				Config: config("v2", 1),
+				Check:  resource.TestCheckResourceAttr(resourceName, "previous_public_tokens.#", "0"),
+			},
+			{
+				Config: config("v3", 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "previous_public_tokens.#", "1"),
+					checkPreviousToken(0, &secondToken),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// The token is retained for a second apply without rotating again.
+				Config: config("v3", 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "previous_public_tokens.#", "1"),
+					checkPreviousToken(0, &secondToken),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// Lowering retain_previous deletes the previous tokens retained for that many applies.
+				Config: config("v3", 0),
+				Check:  resource.TestCheckResourceAttr(resourceName, "previous_public_tokens.#", "0"),
+			},
+		},
+	})
+}
+
 func TestAccPublicTokenResourceStateUpgrade(t *testing.T) {
 	v1Config := testutil.V1ConsumerProjectConfig + `
 resource "stytch_public_token" "test" {
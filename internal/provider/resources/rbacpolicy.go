@@ -3,7 +3,6 @@ package resources
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -19,6 +18,8 @@ import (
 	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
 	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
 	"github.com/stytchauth/stytch-management-go/v3/pkg/models/rbacpolicy"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/providerdata"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/tracing"
 	"github.com/stytchauth/terraform-provider-stytch/internal/provider/utils"
 )
 
@@ -28,6 +29,8 @@ var (
 	_ resource.ResourceWithConfigure    = &rbacPolicyResource{}
 	_ resource.ResourceWithImportState  = &rbacPolicyResource{}
 	_ resource.ResourceWithUpgradeState = &rbacPolicyResource{}
+	_ resource.ResourceWithModifyPlan   = &rbacPolicyResource{}
+	_ resource.ResourceWithIdentity     = &rbacPolicyResource{}
 )
 
 func NewRBACPolicyResource() resource.Resource {
@@ -35,7 +38,11 @@ func NewRBACPolicyResource() resource.Resource {
 }
 
 type rbacPolicyResource struct {
-	client *api.API
+	client     *api.API
+	defaults   providerdata.Defaults
+	readOnly   bool
+	protection *providerdata.Protection
+	verticals  *providerdata.Verticals
 }
 
 type rbacPolicyModel struct {
@@ -149,7 +156,7 @@ func (m rbacPolicyModel) toPolicy(ctx context.Context) (rbacpolicy.Policy, diag.
 func (m *rbacPolicyModel) reloadFromPolicy(ctx context.Context, p rbacpolicy.Policy) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	m.ID = types.StringValue(m.ProjectSlug.ValueString() + "." + m.EnvironmentSlug.ValueString())
+	m.ID = types.StringValue(utils.FormatID(m.ProjectSlug.ValueString(), m.EnvironmentSlug.ValueString()))
 
 	// B2B fields
 	if p.StytchMember != nil {
@@ -419,18 +426,22 @@ func (r *rbacPolicyResource) Configure(ctx context.Context, req resource.Configu
 		return
 	}
 
-	client, ok := req.ProviderData.(*api.API)
+	data, ok := req.ProviderData.(*providerdata.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *api.API (stytch-management-go client), got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.defaults = data.Defaults
+	r.readOnly = data.ReadOnly
+	r.protection = data.Protection
+	r.verticals = data.Verticals
 }
 
 func (r *rbacPolicyResource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
@@ -478,7 +489,7 @@ func (r *rbacPolicyResource) upgradeRBACPolicyStateV0ToV1(
 	}
 
 	newState := rbacPolicyModel{
-		ID:              types.StringValue(fmt.Sprintf("%s.%s", projectSlug, environmentSlug)),
+		ID:              types.StringValue(utils.FormatID(projectSlug, environmentSlug)),
 		ProjectSlug:     types.StringValue(projectSlug),
 		EnvironmentSlug: types.StringValue(environmentSlug),
 		LastUpdated:     types.StringValue(time.Now().Format(time.RFC850)),
@@ -601,17 +612,19 @@ func (r *rbacPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 			"project_slug": schema.StringAttribute{
-				Required:    true,
-				Description: "The slug of the project to which the RBAC policy belongs.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The slug of the project to which the RBAC policy belongs. Defaults to the provider's `default_project_slug`.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"environment_slug": schema.StringAttribute{
-				Required:    true,
-				Description: "The slug of the environment to which the RBAC policy belongs.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The slug of the environment to which the RBAC policy belongs. Defaults to the provider's `default_environment_slug`.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"last_updated": schema.StringAttribute{
@@ -705,47 +718,44 @@ func (r *rbacPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
-func (r rbacPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data rbacPolicyModel
-	diags := req.Config.Get(ctx, &data)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	// If the projectSlug isn't yet known or the provider client is not injected, skip validation for now.
-	if data.ProjectSlug.IsUnknown() || r.client == nil {
-		return
-	}
-
-	getProjectResp, err := r.client.Projects.Get(ctx, projects.GetRequest{
-		ProjectSlug: data.ProjectSlug.ValueString(),
-	})
-	if err != nil {
-		resp.Diagnostics.AddWarning("Failed to get project for vertical check", err.Error())
-		return
-	}
+// rbacPolicyIdentityAttributes are the attributes that identify a RBAC policy, in the order of its
+// import ID.
+var rbacPolicyIdentityAttributes = []string{"project_slug", "environment_slug"}
 
-	// Validate B2B-specific fields are only used with B2B projects
-	if getProjectResp.Project.Vertical == projects.VerticalB2B {
-		if !data.StytchUser.IsNull() && !data.StytchUser.IsUnknown() {
-			resp.Diagnostics.AddError("Invalid field for B2B project", "stytch_user field can only be used with Consumer projects")
-		}
-	}
+// IdentitySchema defines the identity schema for the resource.
+func (r *rbacPolicyResource) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identitySchema(rbacPolicyIdentityAttributes...)
+}
 
-	// Validate Consumer-specific fields are only used with Consumer projects
-	if getProjectResp.Project.Vertical == projects.VerticalConsumer {
-		if !data.StytchMember.IsNull() && !data.StytchMember.IsUnknown() {
-			resp.Diagnostics.AddError("Invalid field for Consumer project", "stytch_member field can only be used with B2B projects")
-		}
-		if !data.StytchAdmin.IsNull() && !data.StytchAdmin.IsUnknown() {
-			resp.Diagnostics.AddError("Invalid field for Consumer project", "stytch_admin field can only be used with B2B projects")
-		}
-	}
+// ModifyPlan plans the provider's default project and environment slugs for the ones omitted
+// from the configuration, checks the default roles against the project's vertical and guards
+// protected environments.
+func (r *rbacPolicyResource) ModifyPlan(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+) {
+	planDefaultProjectSlug(ctx, r.defaults, true, req, resp)
+	planDefaultEnvironmentSlug(ctx, r.defaults, true, req, resp)
+	checkProjectVertical(ctx, r.verticals, "stytch_rbac_policy", "", req, resp,
+		verticalAttribute{path: path.Root("stytch_member"), vertical: projects.VerticalB2B},
+		verticalAttribute{path: path.Root("stytch_admin"), vertical: projects.VerticalB2B},
+		verticalAttribute{path: path.Root("stytch_user"), vertical: projects.VerticalConsumer},
+	)
+	guardProtectedEnvironment(ctx, r.protection, path.Root("environment_slug"), false, req, resp,
+		path.Root("project_slug"), path.Root("environment_slug"))
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *rbacPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_rbac_policy", "Create", req.Plan)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, rbacPolicyIdentityAttributes...)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "create this resource") {
+		return
+	}
+
 	var plan rbacPolicyModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -794,6 +804,10 @@ func (r *rbacPolicyResource) Create(ctx context.Context, req resource.CreateRequ
 
 // Read refreshes the Terraform state with the latest data.
 func (r *rbacPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_rbac_policy", "Read", req.State)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, rbacPolicyIdentityAttributes...)
+
 	var state rbacPolicyModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -810,6 +824,9 @@ func (r *rbacPolicyResource) Read(ctx context.Context, req resource.ReadRequest,
 		EnvironmentSlug: state.EnvironmentSlug.ValueString(),
 	})
 	if err != nil {
+		if removeIfNotFound(ctx, err, resp) {
+			return
+		}
 		resp.Diagnostics.AddError("Failed to get RBAC policy", err.Error())
 		return
 	}
@@ -827,6 +844,14 @@ func (r *rbacPolicyResource) Read(ctx context.Context, req resource.ReadRequest,
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *rbacPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_rbac_policy", "Update", req.Plan)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, rbacPolicyIdentityAttributes...)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "update this resource") {
+		return
+	}
+
 	var plan rbacPolicyModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -875,6 +900,13 @@ func (r *rbacPolicyResource) Update(ctx context.Context, req resource.UpdateRequ
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *rbacPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_rbac_policy", "Delete", req.State)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "delete this resource") {
+		return
+	}
+
 	var state rbacPolicyModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -961,9 +993,8 @@ func (r *rbacPolicyResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 func (r *rbacPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Import ID format: project_slug.environment_slug
-	parts := strings.Split(req.ID, ".")
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError("Invalid import ID", "The ID must be in the format <project_slug>.<environment_slug>")
+	parts, ok := importParts(ctx, req, &resp.Diagnostics, rbacPolicyIdentityAttributes...)
+	if !ok {
 		return
 	}
 
@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -321,6 +322,38 @@ func TestAccRBACPolicyResource_Invalid(t *testing.T) {
 	}
 }
 
+// TestAccRBACPolicyResourceVerticalPlan tests that default roles of the wrong vertical fail the plan
+// of an existing project.
+func TestAccRBACPolicyResourceVerticalPlan(t *testing.T) {
+	projectConfig := testutil.ProviderConfig + testutil.ConsumerProjectConfig +
+		testutil.EnvironmentResource(testutil.EnvironmentResourceArgs{
+			ProjectSlug: "stytch_project.test.project_slug",
+			Name:        "Test Environment",
+		})
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: projectConfig,
+			},
+			{
+				Config: projectConfig + `
+			resource "stytch_rbac_policy" "test" {
+				project_slug     = stytch_project.test.project_slug
+				environment_slug = stytch_environment.test.environment_slug
+
+				stytch_member = {
+					permissions = []
+				}
+			}
+			`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`stytch_member can only be configured for B2B projects`),
+			},
+		},
+	})
+}
+
 func TestAccRBACPolicyResourceStateUpgrade(t *testing.T) {
 	v1Config := testutil.V1B2BProjectConfig + `
 resource "stytch_rbac_policy" "test" {
@@ -3,7 +3,6 @@ package resources
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
@@ -19,6 +18,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
 	"github.com/stytchauth/stytch-management-go/v3/pkg/models/redirecturls"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/providerdata"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/tracing"
 	"github.com/stytchauth/terraform-provider-stytch/internal/provider/utils"
 )
 
@@ -28,6 +29,8 @@ var (
 	_ resource.ResourceWithConfigure    = &redirectURLResource{}
 	_ resource.ResourceWithImportState  = &redirectURLResource{}
 	_ resource.ResourceWithUpgradeState = &redirectURLResource{}
+	_ resource.ResourceWithModifyPlan   = &redirectURLResource{}
+	_ resource.ResourceWithIdentity     = &redirectURLResource{}
 )
 
 func NewRedirectURLResource() resource.Resource {
@@ -35,7 +38,10 @@ func NewRedirectURLResource() resource.Resource {
 }
 
 type redirectURLResource struct {
-	client *api.API
+	client     *api.API
+	defaults   providerdata.Defaults
+	readOnly   bool
+	protection *providerdata.Protection
 }
 
 type redirectURLModel struct {
@@ -83,7 +89,7 @@ var redirectURLResourceLegacySchema = schema.Schema{
 
 // updateModelFromAPI updates the model with values from the API response
 func (r *redirectURLResource) updateModelFromAPI(model *redirectURLModel, redirectURL redirecturls.RedirectURL) {
-	model.ID = types.StringValue(fmt.Sprintf("%s.%s.%s", model.ProjectSlug.ValueString(), model.EnvironmentSlug.ValueString(), model.URL.ValueString()))
+	model.ID = types.StringValue(utils.FormatID(model.ProjectSlug.ValueString(), model.EnvironmentSlug.ValueString(), model.URL.ValueString()))
 	model.URL = types.StringValue(redirectURL.URL)
 	if len(redirectURL.ValidTypes) > 0 {
 		model.ValidTypes = types.SetValueMust(types.ObjectType{AttrTypes: redirectURLTypeModel{}.AttributeTypes()},
@@ -121,18 +127,21 @@ func (r *redirectURLResource) Configure(ctx context.Context, req resource.Config
 		return
 	}
 
-	client, ok := req.ProviderData.(*api.API)
+	data, ok := req.ProviderData.(*providerdata.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *api.API (stytch-management-go client), got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.defaults = data.Defaults
+	r.readOnly = data.ReadOnly
+	r.protection = data.Protection
 }
 
 func (r *redirectURLResource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
@@ -215,17 +224,19 @@ func (r *redirectURLResource) Schema(_ context.Context, _ resource.SchemaRequest
 				},
 			},
 			"project_slug": schema.StringAttribute{
-				Required:    true,
-				Description: "The slug of the project to which the redirect URL belongs.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The slug of the project to which the redirect URL belongs. Defaults to the provider's `default_project_slug`.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"environment_slug": schema.StringAttribute{
-				Required:    true,
-				Description: "The slug of the environment to which the redirect URL belongs.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The slug of the environment to which the redirect URL belongs. Defaults to the provider's `default_environment_slug`.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"last_updated": schema.StringAttribute{
@@ -265,6 +276,17 @@ func (r *redirectURLResource) Schema(_ context.Context, _ resource.SchemaRequest
 	}
 }
 
+// redirectURLIdentityAttributes are the attributes that identify a redirect URL, in the order of
+// its import ID.
+var redirectURLIdentityAttributes = []string{"project_slug", "environment_slug", "url"}
+
+// IdentitySchema defines the identity schema for the resource.
+func (r *redirectURLResource) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identitySchema(redirectURLIdentityAttributes...)
+}
+
 func (m redirectURLModel) toValidTypes() []redirecturls.URLType {
 	var validTypes []redirecturls.URLType
 
@@ -292,8 +314,27 @@ func (m redirectURLModel) toValidTypes() []redirecturls.URLType {
 	return validTypes
 }
 
+// ModifyPlan plans the provider's default project and environment slugs for the ones omitted
+// from the configuration and guards protected environments.
+func (r *redirectURLResource) ModifyPlan(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+) {
+	planDefaultProjectSlug(ctx, r.defaults, true, req, resp)
+	planDefaultEnvironmentSlug(ctx, r.defaults, true, req, resp)
+	guardProtectedEnvironment(ctx, r.protection, path.Root("environment_slug"), false, req, resp,
+		path.Root("project_slug"), path.Root("environment_slug"), path.Root("url"))
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *redirectURLResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_redirect_url", "Create", req.Plan)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, redirectURLIdentityAttributes...)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "create this resource") {
+		return
+	}
+
 	var plan redirectURLModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -306,6 +347,9 @@ func (r *redirectURLResource) Create(ctx context.Context, req resource.CreateReq
 	ctx = tflog.SetField(ctx, "url", plan.URL.ValueString())
 	tflog.Info(ctx, "Creating redirect URL")
 
+	unlock := utils.WriteLocks.Lock(utils.EnvironmentLockKey(utils.LockFamilyRedirectURLs, plan.ProjectSlug.ValueString(), plan.EnvironmentSlug.ValueString()))
+	defer unlock()
+
 	createResp, err := r.client.RedirectURLs.Create(ctx, redirecturls.CreateRequest{
 		ProjectSlug:     plan.ProjectSlug.ValueString(),
 		EnvironmentSlug: plan.EnvironmentSlug.ValueString(),
@@ -334,6 +378,10 @@ func (r *redirectURLResource) Create(ctx context.Context, req resource.CreateReq
 
 // Read refreshes the Terraform state with the latest data.
 func (r *redirectURLResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_redirect_url", "Read", req.State)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, redirectURLIdentityAttributes...)
+
 	// Get the current state
 	var state redirectURLModel
 	diags := req.State.Get(ctx, &state)
@@ -353,6 +401,9 @@ func (r *redirectURLResource) Read(ctx context.Context, req resource.ReadRequest
 		URL:             state.URL.ValueString(),
 	})
 	if err != nil {
+		if removeIfNotFound(ctx, err, resp) {
+			return
+		}
 		resp.Diagnostics.AddError("Failed to get redirect URL", err.Error())
 		return
 	}
@@ -369,6 +420,14 @@ func (r *redirectURLResource) Read(ctx context.Context, req resource.ReadRequest
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *redirectURLResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_redirect_url", "Update", req.Plan)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, redirectURLIdentityAttributes...)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "update this resource") {
+		return
+	}
+
 	var plan redirectURLModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -381,6 +440,9 @@ func (r *redirectURLResource) Update(ctx context.Context, req resource.UpdateReq
 	ctx = tflog.SetField(ctx, "url", plan.URL.ValueString())
 	tflog.Info(ctx, "Updating redirect URL")
 
+	unlock := utils.WriteLocks.Lock(utils.EnvironmentLockKey(utils.LockFamilyRedirectURLs, plan.ProjectSlug.ValueString(), plan.EnvironmentSlug.ValueString()))
+	defer unlock()
+
 	updateResp, err := r.client.RedirectURLs.Update(ctx, redirecturls.UpdateRequest{
 		ProjectSlug:     plan.ProjectSlug.ValueString(),
 		EnvironmentSlug: plan.EnvironmentSlug.ValueString(),
@@ -409,6 +471,13 @@ func (r *redirectURLResource) Update(ctx context.Context, req resource.UpdateReq
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *redirectURLResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_redirect_url", "Delete", req.State)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "delete this resource") {
+		return
+	}
+
 	var state redirectURLModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -421,6 +490,9 @@ func (r *redirectURLResource) Delete(ctx context.Context, req resource.DeleteReq
 	ctx = tflog.SetField(ctx, "url", state.URL.ValueString())
 	tflog.Info(ctx, "Deleting redirect URL")
 
+	unlock := utils.WriteLocks.Lock(utils.EnvironmentLockKey(utils.LockFamilyRedirectURLs, state.ProjectSlug.ValueString(), state.EnvironmentSlug.ValueString()))
+	defer unlock()
+
 	_, err := r.client.RedirectURLs.Delete(ctx, redirecturls.DeleteRequest{
 		ProjectSlug:     state.ProjectSlug.ValueString(),
 		EnvironmentSlug: state.EnvironmentSlug.ValueString(),
@@ -440,9 +512,8 @@ func (r *redirectURLResource) Delete(ctx context.Context, req resource.DeleteReq
 
 func (r *redirectURLResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Import ID format: project_slug.environment_slug.url
-	parts := strings.SplitN(req.ID, ".", 3)
-	if len(parts) != 3 {
-		resp.Diagnostics.AddError("Invalid import ID", "The ID must be in the format <project_slug>.<environment_slug>.<url>")
+	parts, ok := importParts(ctx, req, &resp.Diagnostics, redirectURLIdentityAttributes...)
+	if !ok {
 		return
 	}
 
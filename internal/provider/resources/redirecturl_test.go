@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -8,6 +9,10 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
 	"github.com/stytchauth/stytch-management-go/v3/pkg/models/redirecturls"
 	"github.com/stytchauth/terraform-provider-stytch/internal/provider/testutil"
 )
@@ -171,3 +176,153 @@ resource "stytch_redirect_url" "test" {
 		})
 	})
 }
+
+func TestAccRedirectURLResourceProviderDefaults(t *testing.T) {
+	projectSlug := "test-acc-redirect-url-provider-defaults"
+	providerConfig := fmt.Sprintf(`
+provider "stytch" {
+  default_project_slug     = "%s"
+  default_environment_slug = "production"
+}
+`, projectSlug)
+	resourceConfig := `
+resource "stytch_redirect_url" "test" {
+  url         = "http://localhost:3000/consumer"
+  valid_types = [{type = "LOGIN", is_default = true}]
+  depends_on  = [stytch_project.test]
+}
+`
+	projectConfig := testutil.ProjectResource(testutil.ProjectResourceArgs{
+		Name:               "test-consumer",
+		Vertical:           projects.VerticalConsumer,
+		ProjectSlug:        &projectSlug,
+		DeletionProtection: testutil.Unprotected(),
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Without provider defaults, omitting the slugs fails at plan time.
+				Config:      testutil.ProviderConfig + projectConfig + resourceConfig,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`default_project_slug`),
+			},
+			{
+				Config: providerConfig + projectConfig + resourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("stytch_redirect_url.test", "project_slug", projectSlug),
+					resource.TestCheckResourceAttr("stytch_redirect_url.test", "environment_slug", "production"),
+					resource.TestCheckResourceAttr("stytch_redirect_url.test", "id",
+						projectSlug+".production.http://localhost:3000/consumer"),
+				),
+			},
+			{
+				// Setting the slugs explicitly to the default values doesn't change anything.
+				Config: providerConfig + projectConfig + fmt.Sprintf(`
+resource "stytch_redirect_url" "test" {
+  project_slug     = "%s"
+  environment_slug = "production"
+  url              = "http://localhost:3000/consumer"
+  valid_types      = [{type = "LOGIN", is_default = true}]
+  depends_on       = [stytch_project.test]
+}
+`, projectSlug),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccRedirectURLResourceDeletedOutsideTerraform(t *testing.T) {
+	projectSlug := "test-acc-redirect-url-deleted-outside-terraform"
+	config := testutil.ProviderConfig + testutil.ProjectResource(testutil.ProjectResourceArgs{
+		Name:               "test-consumer",
+		Vertical:           projects.VerticalConsumer,
+		ProjectSlug:        &projectSlug,
+		DeletionProtection: testutil.Unprotected(),
+	}) + `
+resource "stytch_redirect_url" "test" {
+  project_slug     = stytch_project.test.project_slug
+  environment_slug = stytch_project.test.live_environment.environment_slug
+  url              = "http://localhost:3000/consumer"
+  valid_types      = [{type = "LOGIN", is_default = true}]
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				// A redirect URL deleted in the dashboard is planned to be created again.
+				PreConfig: func() {
+					_, err := testutil.Client().RedirectURLs.Delete(context.Background(), redirecturls.DeleteRequest{
+						ProjectSlug:     projectSlug,
+						EnvironmentSlug: "production",
+						URL:             "http://localhost:3000/consumer",
+					})
+					if err != nil {
+						t.Fatalf("failed to delete redirect URL: %v", err)
+					}
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttrSet("stytch_redirect_url.test", "id"),
+			},
+		},
+	})
+}
+
+func TestAccRedirectURLResourceIdentity(t *testing.T) {
+	projectSlug := "test-acc-redirect-url-identity"
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testutil.ProviderConfig + testutil.ProjectResource(testutil.ProjectResourceArgs{
+					Name:               "test-consumer",
+					Vertical:           projects.VerticalConsumer,
+					ProjectSlug:        &projectSlug,
+					DeletionProtection: testutil.Unprotected(),
+				}) + `
+resource "stytch_redirect_url" "test" {
+  project_slug     = stytch_project.test.project_slug
+  environment_slug = stytch_project.test.live_environment.environment_slug
+  url              = "https://app.example.com/callback"
+  valid_types      = [{type = "LOGIN", is_default = true}]
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("stytch_redirect_url.test", map[string]knownvalue.Check{
+						"project_slug":     knownvalue.StringExact(projectSlug),
+						"environment_slug": knownvalue.StringExact("production"),
+						"url":              knownvalue.StringExact("https://app.example.com/callback"),
+					}),
+				},
+			},
+			{
+				// A URL with dots can be imported by identity.
+				ResourceName:    "stytch_redirect_url.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+			{
+				// ... and by ID.
+				ResourceName:            "stytch_redirect_url.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+		},
+	})
+}
@@ -0,0 +1,624 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/redirecturls"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/providerdata"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/tracing"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &redirectURLsResource{}
+	_ resource.ResourceWithConfigure        = &redirectURLsResource{}
+	_ resource.ResourceWithImportState      = &redirectURLsResource{}
+	_ resource.ResourceWithModifyPlan       = &redirectURLsResource{}
+	_ resource.ResourceWithConfigValidators = &redirectURLsResource{}
+	_ resource.ResourceWithIdentity         = &redirectURLsResource{}
+)
+
+func NewRedirectURLsResource() resource.Resource {
+	return &redirectURLsResource{}
+}
+
+// redirectURLsResource manages every redirect URL of an environment, unlike redirectURLResource,
+// which manages a single one and leaves the others alone.
+type redirectURLsResource struct {
+	client     *api.API
+	defaults   providerdata.Defaults
+	readOnly   bool
+	protection *providerdata.Protection
+}
+
+type redirectURLsModel struct {
+	ID              types.String `tfsdk:"id"`
+	ProjectSlug     types.String `tfsdk:"project_slug"`
+	EnvironmentSlug types.String `tfsdk:"environment_slug"`
+	RedirectURLs    types.Map    `tfsdk:"redirect_urls"`
+	LastUpdated     types.String `tfsdk:"last_updated"`
+}
+
+type redirectURLsEntryModel struct {
+	ValidTypes types.Set `tfsdk:"valid_types"`
+}
+
+func (m redirectURLsEntryModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"valid_types": types.SetType{ElemType: types.ObjectType{AttrTypes: redirectURLTypeModel{}.AttributeTypes()}},
+	}
+}
+
+// toRedirectURLs returns the valid types of each redirect URL of the model, keyed by URL.
+func (m redirectURLsModel) toRedirectURLs(ctx context.Context, diags *diag.Diagnostics) map[string][]redirecturls.URLType {
+	var entries map[string]redirectURLsEntryModel
+	diags.Append(m.RedirectURLs.ElementsAs(ctx, &entries, false)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	redirectURLs := make(map[string][]redirecturls.URLType, len(entries))
+	for url, entry := range entries {
+		var validTypes []redirectURLTypeModel
+		diags.Append(entry.ValidTypes.ElementsAs(ctx, &validTypes, false)...)
+		if diags.HasError() {
+			return nil
+		}
+		for _, validType := range validTypes {
+			redirectURLs[url] = append(redirectURLs[url], redirecturls.URLType{
+				Type:      redirecturls.RedirectURLType(validType.Type.ValueString()),
+				IsDefault: validType.IsDefault.ValueBool(),
+			})
+		}
+	}
+	return redirectURLs
+}
+
+// updateModelFromAPI updates the model with values from the API response
+func (r *redirectURLsResource) updateModelFromAPI(model *redirectURLsModel, redirectURLs []redirecturls.RedirectURL) {
+	model.ID = types.StringValue(utils.FormatID(model.ProjectSlug.ValueString(), model.EnvironmentSlug.ValueString()))
+
+	entries := make(map[string]attr.Value, len(redirectURLs))
+	for _, redirectURL := range redirectURLs {
+		validTypes := make([]attr.Value, len(redirectURL.ValidTypes))
+		for i, vt := range redirectURL.ValidTypes {
+			validTypes[i] = types.ObjectValueMust(redirectURLTypeModel{}.AttributeTypes(), map[string]attr.Value{
+				"type":       types.StringValue(string(vt.Type)),
+				"is_default": types.BoolValue(vt.IsDefault),
+			})
+		}
+		entries[redirectURL.URL] = types.ObjectValueMust(redirectURLsEntryModel{}.AttributeTypes(), map[string]attr.Value{
+			"valid_types": types.SetValueMust(types.ObjectType{AttrTypes: redirectURLTypeModel{}.AttributeTypes()}, validTypes),
+		})
+	}
+	model.RedirectURLs = types.MapValueMust(types.ObjectType{AttrTypes: redirectURLsEntryModel{}.AttributeTypes()}, entries)
+}
+
+func (r *redirectURLsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerdata.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.Client
+	r.defaults = data.Defaults
+	r.readOnly = data.ReadOnly
+	r.protection = data.Protection
+}
+
+// Metadata returns the resource type name.
+func (r *redirectURLsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_redirect_urls"
+}
+
+// Schema defines the schema for the resource.
+func (r *redirectURLsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The full set of redirect URLs for an environment. Redirect URLs that aren't in the configuration, " +
+			"such as ones added in the dashboard, are deleted. Don't use it together with `stytch_redirect_url` " +
+			"resources for the same environment.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "A computed ID field used for Terraform resource management (format: project_slug.environment_slug).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_slug": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The slug of the project to which the redirect URLs belong. Defaults to the provider's `default_project_slug`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"environment_slug": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The slug of the environment to which the redirect URLs belong. Defaults to the provider's `default_environment_slug`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"redirect_urls": schema.MapNestedAttribute{
+				Description: "The redirect URLs of the environment, keyed by URL. Each type used by a redirect URL must " +
+					"have exactly one default redirect URL.",
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"valid_types": schema.SetNestedAttribute{
+							Description: "The set of valid types for the redirect URL.",
+							Required:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										Required:    true,
+										Description: "The type of the redirect URL.",
+										Validators: []validator.String{
+											stringvalidator.OneOf(toStrings(redirecturls.RedirectURLTypes())...),
+										},
+									},
+									"is_default": schema.BoolAttribute{
+										Required:    true,
+										Description: "Whether or not this is the default redirect URL for the given type.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// redirectURLsIdentityAttributes are the attributes that identify a set of redirect URLs, in the
+// order of its import ID.
+var redirectURLsIdentityAttributes = []string{"project_slug", "environment_slug"}
+
+// IdentitySchema defines the identity schema for the resource.
+func (r *redirectURLsResource) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identitySchema(redirectURLsIdentityAttributes...)
+}
+
+// ConfigValidators returns validators for the resource configuration.
+func (r *redirectURLsResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		&redirectURLDefaultsValidator{},
+	}
+}
+
+// redirectURLDefaultsValidator ensures each redirect URL type has exactly one default redirect URL.
+type redirectURLDefaultsValidator struct{}
+
+func (v *redirectURLDefaultsValidator) Description(ctx context.Context) string {
+	return "Validates that each redirect URL type has exactly one default redirect URL"
+}
+
+func (v *redirectURLDefaultsValidator) MarkdownDescription(ctx context.Context) string {
+	return "Validates that each redirect URL type has exactly one default redirect URL"
+}
+
+func (v *redirectURLDefaultsValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config redirectURLsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only validate once every redirect URL is known.
+	if config.RedirectURLs.IsNull() || config.RedirectURLs.IsUnknown() {
+		return
+	}
+	for _, entry := range config.RedirectURLs.Elements() {
+		if !isFullyKnown(entry) {
+			return
+		}
+	}
+
+	redirectURLs := config.toRedirectURLs(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defaults := map[redirecturls.RedirectURLType][]string{}
+	for url, validTypes := range redirectURLs {
+		for _, validType := range validTypes {
+			if _, ok := defaults[validType.Type]; !ok {
+				defaults[validType.Type] = nil
+			}
+			if validType.IsDefault {
+				defaults[validType.Type] = append(defaults[validType.Type], url)
+			}
+		}
+	}
+
+	urlTypes := make([]string, 0, len(defaults))
+	for urlType := range defaults {
+		urlTypes = append(urlTypes, string(urlType))
+	}
+	sort.Strings(urlTypes)
+
+	for _, urlType := range urlTypes {
+		urls := defaults[redirecturls.RedirectURLType(urlType)]
+		switch len(urls) {
+		case 1:
+			continue
+		case 0:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("redirect_urls"),
+				"Invalid Attribute Configuration",
+				fmt.Sprintf("No redirect URL is the default for type %s; exactly one must be", urlType),
+			)
+		default:
+			sort.Strings(urls)
+			resp.Diagnostics.AddAttributeError(
+				path.Root("redirect_urls"),
+				"Invalid Attribute Configuration",
+				fmt.Sprintf("Redirect URLs %s are all the default for type %s; exactly one must be",
+					strings.Join(urls, ", "), urlType),
+			)
+		}
+	}
+}
+
+// isFullyKnown reports whether value and every value nested in it are known.
+func isFullyKnown(value attr.Value) bool {
+	if value.IsUnknown() {
+		return false
+	}
+	switch v := value.(type) {
+	case types.Object:
+		for _, attribute := range v.Attributes() {
+			if !isFullyKnown(attribute) {
+				return false
+			}
+		}
+	case types.Set:
+		for _, element := range v.Elements() {
+			if !isFullyKnown(element) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// ModifyPlan plans the provider's default project and environment slugs for the ones omitted
+// from the configuration and guards protected environments.
+func (r *redirectURLsResource) ModifyPlan(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+) {
+	planDefaultProjectSlug(ctx, r.defaults, true, req, resp)
+	planDefaultEnvironmentSlug(ctx, r.defaults, true, req, resp)
+	guardProtectedEnvironment(ctx, r.protection, path.Root("environment_slug"), false, req, resp,
+		path.Root("project_slug"), path.Root("environment_slug"))
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *redirectURLsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_redirect_urls", "Create", req.Plan)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, redirectURLsIdentityAttributes...)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "create this resource") {
+		return
+	}
+
+	var plan redirectURLsModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "project_slug", plan.ProjectSlug.ValueString())
+	ctx = tflog.SetField(ctx, "environment_slug", plan.EnvironmentSlug.ValueString())
+	tflog.Info(ctx, "Creating redirect URLs")
+
+	redirectURLs := r.reconcile(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Created redirect URLs")
+
+	r.updateModelFromAPI(&plan, redirectURLs)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data. Redirect URLs added outside of
+// Terraform are read into the state, so that the next plan deletes them.
+func (r *redirectURLsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_redirect_urls", "Read", req.State)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, redirectURLsIdentityAttributes...)
+
+	var state redirectURLsModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "project_slug", state.ProjectSlug.ValueString())
+	ctx = tflog.SetField(ctx, "environment_slug", state.EnvironmentSlug.ValueString())
+	tflog.Info(ctx, "Reading redirect URLs")
+
+	getResp, err := r.client.RedirectURLs.GetAll(ctx, redirecturls.GetAllRequest{
+		ProjectSlug:     state.ProjectSlug.ValueString(),
+		EnvironmentSlug: state.EnvironmentSlug.ValueString(),
+	})
+	if err != nil {
+		if removeIfNotFound(ctx, err, resp) {
+			return
+		}
+		resp.Diagnostics.AddError("Failed to get redirect URLs", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Read redirect URLs")
+
+	r.updateModelFromAPI(&state, getResp.RedirectURLs)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *redirectURLsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_redirect_urls", "Update", req.Plan)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, redirectURLsIdentityAttributes...)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "update this resource") {
+		return
+	}
+
+	var plan redirectURLsModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "project_slug", plan.ProjectSlug.ValueString())
+	ctx = tflog.SetField(ctx, "environment_slug", plan.EnvironmentSlug.ValueString())
+	tflog.Info(ctx, "Updating redirect URLs")
+
+	redirectURLs := r.reconcile(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Updated redirect URLs")
+
+	r.updateModelFromAPI(&plan, redirectURLs)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// reconcile makes the redirect URLs of the environment match the plan: it deletes the ones that
+// aren't planned, updates the ones whose valid types changed and creates the missing ones. It
+// returns the redirect URLs of the environment once they match.
+func (r *redirectURLsResource) reconcile(
+	ctx context.Context, plan redirectURLsModel, diags *diag.Diagnostics,
+) []redirecturls.RedirectURL {
+	projectSlug := plan.ProjectSlug.ValueString()
+	environmentSlug := plan.EnvironmentSlug.ValueString()
+
+	planned := plan.toRedirectURLs(ctx, diags)
+	if diags.HasError() {
+		return nil
+	}
+
+	unlock := utils.WriteLocks.Lock(utils.EnvironmentLockKey(utils.LockFamilyRedirectURLs, projectSlug, environmentSlug))
+	defer unlock()
+
+	getResp, err := r.client.RedirectURLs.GetAll(ctx, redirecturls.GetAllRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: environmentSlug,
+	})
+	if err != nil {
+		diags.AddError("Failed to get redirect URLs", err.Error())
+		return nil
+	}
+
+	existing := make(map[string][]redirecturls.URLType, len(getResp.RedirectURLs))
+	for _, redirectURL := range getResp.RedirectURLs {
+		existing[redirectURL.URL] = redirectURL.ValidTypes
+	}
+
+	// Deleting first means no default is ever shared with a redirect URL that is going away.
+	for _, url := range sortedKeys(existing) {
+		if _, ok := planned[url]; ok {
+			continue
+		}
+		tflog.Info(ctx, "Deleting redirect URL", map[string]interface{}{"url": url})
+		_, err := r.client.RedirectURLs.Delete(ctx, redirecturls.DeleteRequest{
+			ProjectSlug:     projectSlug,
+			EnvironmentSlug: environmentSlug,
+			URL:             url,
+			// We explicitly disable default promotion logic because if the terraform provisioner specified that a redirect URL
+			// is *not* the default for a given type, if the API tries to override it to true, it will result in a provider
+			// inconsistency error.
+			DoNotPromoteDefaults: ptr(true),
+		})
+		if err != nil {
+			diags.AddError("Failed to delete redirect URL", fmt.Sprintf("%s: %s", url, err.Error()))
+			return nil
+		}
+	}
+
+	result := make([]redirecturls.RedirectURL, 0, len(planned))
+	for _, url := range sortedKeys(planned) {
+		validTypes := planned[url]
+		current, ok := existing[url]
+		switch {
+		case !ok:
+			tflog.Info(ctx, "Creating redirect URL", map[string]interface{}{"url": url})
+			createResp, err := r.client.RedirectURLs.Create(ctx, redirecturls.CreateRequest{
+				ProjectSlug:          projectSlug,
+				EnvironmentSlug:      environmentSlug,
+				URL:                  url,
+				ValidTypes:           validTypes,
+				DoNotPromoteDefaults: ptr(true),
+			})
+			if err != nil {
+				diags.AddError("Failed to create redirect URL", fmt.Sprintf("%s: %s", url, err.Error()))
+				return nil
+			}
+			result = append(result, createResp.RedirectURL)
+		case !sameValidTypes(current, validTypes):
+			tflog.Info(ctx, "Updating redirect URL", map[string]interface{}{"url": url})
+			updateResp, err := r.client.RedirectURLs.Update(ctx, redirecturls.UpdateRequest{
+				ProjectSlug:          projectSlug,
+				EnvironmentSlug:      environmentSlug,
+				URL:                  url,
+				ValidTypes:           validTypes,
+				DoNotPromoteDefaults: ptr(true),
+			})
+			if err != nil {
+				diags.AddError("Failed to update redirect URL", fmt.Sprintf("%s: %s", url, err.Error()))
+				return nil
+			}
+			result = append(result, updateResp.RedirectURL)
+		default:
+			result = append(result, redirecturls.RedirectURL{URL: url, ValidTypes: current})
+		}
+	}
+	return result
+}
+
+// sameValidTypes reports whether a and b hold the same valid types, in any order.
+func sameValidTypes(a, b []redirecturls.URLType) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	counts := make(map[redirecturls.URLType]int, len(a))
+	for _, validType := range a {
+		counts[validType]++
+	}
+	for _, validType := range b {
+		if counts[validType] == 0 {
+			return false
+		}
+		counts[validType]--
+	}
+	return true
+}
+
+// sortedKeys returns the keys of m in order, so that redirect URLs are changed in a stable order.
+func sortedKeys(m map[string][]redirecturls.URLType) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *redirectURLsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_redirect_urls", "Delete", req.State)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "delete this resource") {
+		return
+	}
+
+	var state redirectURLsModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "project_slug", state.ProjectSlug.ValueString())
+	ctx = tflog.SetField(ctx, "environment_slug", state.EnvironmentSlug.ValueString())
+	tflog.Info(ctx, "Deleting redirect URLs")
+
+	redirectURLs := state.toRedirectURLs(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unlock := utils.WriteLocks.Lock(utils.EnvironmentLockKey(utils.LockFamilyRedirectURLs, state.ProjectSlug.ValueString(), state.EnvironmentSlug.ValueString()))
+	defer unlock()
+
+	for _, url := range sortedKeys(redirectURLs) {
+		_, err := r.client.RedirectURLs.Delete(ctx, redirecturls.DeleteRequest{
+			ProjectSlug:     state.ProjectSlug.ValueString(),
+			EnvironmentSlug: state.EnvironmentSlug.ValueString(),
+			URL:             url,
+			// We explicitly disable default promotion logic because if the terraform provisioner specified that a redirect URL
+			// is *not* the default for a given type, if the API tries to override it to true, it will result in a provider
+			// inconsistency error.
+			DoNotPromoteDefaults: ptr(true),
+		})
+		if err != nil && !utils.IsNotFound(err) {
+			resp.Diagnostics.AddError("Failed to delete redirect URL", fmt.Sprintf("%s: %s", url, err.Error()))
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Deleted redirect URLs")
+}
+
+func (r *redirectURLsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import ID format: project_slug.environment_slug
+	parts, ok := importParts(ctx, req, &resp.Diagnostics, redirectURLsIdentityAttributes...)
+	if !ok {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "project_slug", parts[0])
+	ctx = tflog.SetField(ctx, "environment_slug", parts[1])
+	tflog.Info(ctx, "Importing redirect URLs")
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), utils.FormatID(parts...))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_slug"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_slug"), parts[1])...)
+}
@@ -0,0 +1,135 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/redirecturls"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/testutil"
+)
+
+func redirectURLsResource(projectSlug, redirectURLs string) string {
+	return testutil.ProviderConfig + testutil.ProjectResource(testutil.ProjectResourceArgs{
+		Name:               "test-consumer",
+		Vertical:           projects.VerticalConsumer,
+		ProjectSlug:        &projectSlug,
+		DeletionProtection: testutil.Unprotected(),
+	}) + fmt.Sprintf(`
+resource "stytch_redirect_urls" "test" {
+  project_slug     = stytch_project.test.project_slug
+  environment_slug = stytch_project.test.live_environment.environment_slug
+  redirect_urls = {
+%s
+  }
+}
+`, redirectURLs)
+}
+
+func TestAccRedirectURLsResource(t *testing.T) {
+	projectSlug := "test-acc-redirect-urls"
+	config := redirectURLsResource(projectSlug, `
+    "http://localhost:3000/login"  = { valid_types = [{ type = "LOGIN", is_default = true }] }
+    "http://localhost:3000/signup" = { valid_types = [{ type = "SIGNUP", is_default = true }, { type = "LOGIN", is_default = false }] }
+`)
+	updateConfig := redirectURLsResource(projectSlug, `
+    "http://localhost:3000/login"  = { valid_types = [{ type = "LOGIN", is_default = false }] }
+    "http://localhost:3000/signup" = { valid_types = [{ type = "SIGNUP", is_default = true }, { type = "LOGIN", is_default = true }] }
+`)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create and Read testing
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("stytch_redirect_urls.test", "id", projectSlug+".production"),
+					resource.TestCheckResourceAttr("stytch_redirect_urls.test", "redirect_urls.%", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("stytch_redirect_urls.test",
+						"redirect_urls.http://localhost:3000/signup.valid_types.*", map[string]string{
+							"type":       "LOGIN",
+							"is_default": "false",
+						}),
+				),
+			},
+			{
+				// ImportState testing
+				ResourceName:            "stytch_redirect_urls.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				// A redirect URL added in the dashboard is planned to be deleted.
+				PreConfig: func() {
+					_, err := testutil.Client().RedirectURLs.Create(context.Background(), redirecturls.CreateRequest{
+						ProjectSlug:     projectSlug,
+						EnvironmentSlug: "production",
+						URL:             "http://localhost:3000/stale",
+						ValidTypes: []redirecturls.URLType{
+							{Type: redirecturls.RedirectURLTypeLogin, IsDefault: false},
+						},
+					})
+					if err != nil {
+						t.Fatalf("failed to create redirect URL: %v", err)
+					}
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// Update and Read testing, which also deletes the unmanaged redirect URL
+				Config: updateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("stytch_redirect_urls.test", "redirect_urls.%", "2"),
+					resource.TestCheckNoResourceAttr("stytch_redirect_urls.test",
+						"redirect_urls.http://localhost:3000/stale.valid_types.#"),
+					resource.TestCheckTypeSetElemNestedAttrs("stytch_redirect_urls.test",
+						"redirect_urls.http://localhost:3000/signup.valid_types.*", map[string]string{
+							"type":       "LOGIN",
+							"is_default": "true",
+						}),
+				),
+			},
+			// Delete testing automatically occurs in resource.TestCase
+		},
+	})
+}
+
+func TestRedirectURLsDefaultsValidation(t *testing.T) {
+	for name, testCase := range map[string]struct {
+		redirectURLs string
+		expectError  string
+	}{
+		"no default": {
+			redirectURLs: `
+    "http://localhost:3000/login" = { valid_types = [{ type = "LOGIN", is_default = false }] }
+`,
+			expectError: "No redirect URL is the default for type LOGIN",
+		},
+		"several defaults": {
+			redirectURLs: `
+    "http://localhost:3000/a" = { valid_types = [{ type = "LOGIN", is_default = true }] }
+    "http://localhost:3000/b" = { valid_types = [{ type = "LOGIN", is_default = true }] }
+`,
+			expectError: "are all the default for type LOGIN",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config:      redirectURLsResource("test-redirect-urls-validation", testCase.redirectURLs),
+						ExpectError: regexp.MustCompile(testCase.expectError),
+					},
+				},
+			})
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -13,6 +14,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
 	"github.com/stytchauth/stytch-management-go/v3/pkg/models/secrets"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/providerdata"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/tracing"
 	"github.com/stytchauth/terraform-provider-stytch/internal/provider/utils"
 )
 
@@ -21,6 +24,8 @@ var (
 	_ resource.Resource                 = &secretResource{}
 	_ resource.ResourceWithConfigure    = &secretResource{}
 	_ resource.ResourceWithUpgradeState = &secretResource{}
+	_ resource.ResourceWithModifyPlan   = &secretResource{}
+	_ resource.ResourceWithIdentity     = &secretResource{}
 )
 
 func NewSecretResource() resource.Resource {
@@ -28,7 +33,10 @@ func NewSecretResource() resource.Resource {
 }
 
 type secretResource struct {
-	client *api.API
+	client     *api.API
+	defaults   providerdata.Defaults
+	readOnly   bool
+	protection *providerdata.Protection
 }
 
 type secretModel struct {
@@ -73,18 +81,21 @@ func (r *secretResource) Configure(
 		return
 	}
 
-	client, ok := req.ProviderData.(*api.API)
+	data, ok := req.ProviderData.(*providerdata.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *api.API (stytch-management-go client), got: %T. Please report "+
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report "+
 				"this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.defaults = data.Defaults
+	r.readOnly = data.ReadOnly
+	r.protection = data.Protection
 }
 
 func (r *secretResource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
@@ -157,17 +168,19 @@ func (r *secretResource) Schema(
 				},
 			},
 			"project_slug": schema.StringAttribute{
-				Required:    true,
-				Description: "The slug of the project to which the secret belongs.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The slug of the project to which the secret belongs. Defaults to the provider's `default_project_slug`.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"environment_slug": schema.StringAttribute{
-				Required:    true,
-				Description: "The slug of the environment to which the secret belongs.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The slug of the environment to which the secret belongs. Defaults to the provider's `default_environment_slug`.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"created_at": schema.StringAttribute{
@@ -189,10 +202,40 @@ func (r *secretResource) Schema(
 	}
 }
 
+// secretIdentityAttributes are the attributes that identify a secret. This resource doesn't support
+// import, so the identity is only reported to Terraform and never used to import a secret.
+var secretIdentityAttributes = []string{"project_slug", "environment_slug", "secret_id"}
+
+// IdentitySchema defines the identity schema for the resource.
+func (r *secretResource) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identitySchema(secretIdentityAttributes...)
+}
+
+// ModifyPlan plans the provider's default project and environment slugs for the ones omitted
+// from the configuration and guards protected environments.
+func (r *secretResource) ModifyPlan(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+) {
+	planDefaultProjectSlug(ctx, r.defaults, true, req, resp)
+	planDefaultEnvironmentSlug(ctx, r.defaults, true, req, resp)
+	guardProtectedEnvironment(ctx, r.protection, path.Root("environment_slug"), false, req, resp,
+		path.Root("project_slug"), path.Root("environment_slug"))
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *secretResource) Create(
 	ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse,
 ) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_secret", "Create", req.Plan)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, secretIdentityAttributes...)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "create this resource") {
+		return
+	}
+
 	var plan secretModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -232,6 +275,10 @@ func (r *secretResource) Create(
 func (r *secretResource) Read(
 	ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse,
 ) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_secret", "Read", req.State)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, secretIdentityAttributes...)
+
 	// Get the current state
 	var state secretModel
 	diags := req.State.Get(ctx, &state)
@@ -251,6 +298,9 @@ func (r *secretResource) Read(
 		SecretID:        state.SecretID.ValueString(),
 	})
 	if err != nil {
+		if removeIfNotFound(ctx, err, resp) {
+			return
+		}
 		resp.Diagnostics.AddError("Failed to get secret", err.Error())
 		return
 	}
@@ -279,6 +329,13 @@ func (r *secretResource) Update(
 func (r *secretResource) Delete(
 	ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse,
 ) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_secret", "Delete", req.State)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "delete this resource") {
+		return
+	}
+
 	var state secretModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
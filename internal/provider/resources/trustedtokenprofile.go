@@ -2,8 +2,12 @@ package resources
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -21,6 +25,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
 	"github.com/stytchauth/stytch-management-go/v3/pkg/models/trustedtokenprofiles"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/providerdata"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/tracing"
 	"github.com/stytchauth/terraform-provider-stytch/internal/provider/utils"
 )
 
@@ -30,6 +36,8 @@ var (
 	_ resource.ResourceWithConfigure    = &trustedTokenProfileResource{}
 	_ resource.ResourceWithImportState  = &trustedTokenProfileResource{}
 	_ resource.ResourceWithUpgradeState = &trustedTokenProfileResource{}
+	_ resource.ResourceWithModifyPlan   = &trustedTokenProfileResource{}
+	_ resource.ResourceWithIdentity     = &trustedTokenProfileResource{}
 )
 
 func NewTrustedTokenProfileResource() resource.Resource {
@@ -37,7 +45,10 @@ func NewTrustedTokenProfileResource() resource.Resource {
 }
 
 type trustedTokenProfileResource struct {
-	client *api.API
+	client     *api.API
+	defaults   providerdata.Defaults
+	readOnly   bool
+	protection *providerdata.Protection
 }
 
 type trustedTokenProfileModel struct {
@@ -119,17 +130,94 @@ var trustedTokenProfileResourceLegacySchema = schema.Schema{
 }
 
 type pemFileModel struct {
-	PEMFileID types.String `tfsdk:"pem_file_id"`
-	PublicKey types.String `tfsdk:"public_key"`
+	PEMFileID      types.String `tfsdk:"pem_file_id"`
+	PublicKey      types.String `tfsdk:"public_key"`
+	KeyFingerprint types.String `tfsdk:"key_fingerprint"`
 }
 
 func (m pemFileModel) AttributeTypes() map[string]attr.Type {
 	return map[string]attr.Type{
-		"pem_file_id": types.StringType,
-		"public_key":  types.StringType,
+		"pem_file_id":     types.StringType,
+		"public_key":      types.StringType,
+		"key_fingerprint": types.StringType,
 	}
 }
 
+// pemFingerprint returns the hex-encoded SHA-256 digest of the DER bytes in a PEM-encoded public
+// key so that keys which only differ in whitespace or line wrapping compare as equal. If the
+// content cannot be decoded as PEM, the digest is taken over the content with all whitespace
+// removed instead.
+func pemFingerprint(publicKey string) string {
+	data := []byte(strings.Join(strings.Fields(publicKey), ""))
+	if block, _ := pem.Decode([]byte(publicKey)); block != nil {
+		data = block.Bytes
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// pemFilesFromSet extracts the PEM files with a known public key from a pem_files set.
+func pemFilesFromSet(ctx context.Context, set types.Set) ([]pemFileModel, diag.Diagnostics) {
+	var pemFiles []pemFileModel
+	if set.IsNull() || set.IsUnknown() {
+		return pemFiles, nil
+	}
+
+	var elems []pemFileModel
+	diags := set.ElementsAs(ctx, &elems, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for _, elem := range elems {
+		if elem.PublicKey.IsNull() || elem.PublicKey.IsUnknown() {
+			continue
+		}
+		pemFiles = append(pemFiles, elem)
+	}
+	return pemFiles, diags
+}
+
+// pemFilesSetValue converts the PEM files returned by the API into a pem_files set. When a key in
+// prior (the plan or the previous state) has the same fingerprint as a key returned by the API,
+// the prior public_key is kept as-is so that formatting differences don't show up as drift.
+func pemFilesSetValue(
+	ctx context.Context, apiPEMFiles []trustedtokenprofiles.PEMFile, prior types.Set,
+) (types.Set, diag.Diagnostics) {
+	elemType := types.ObjectType{AttrTypes: pemFileModel{}.AttributeTypes()}
+	if len(apiPEMFiles) == 0 {
+		return types.SetNull(elemType), nil
+	}
+
+	priorPEMFiles, diags := pemFilesFromSet(ctx, prior)
+	if diags.HasError() {
+		return types.SetNull(elemType), diags
+	}
+
+	priorKeys := make(map[string]string, len(priorPEMFiles))
+	for _, pemFile := range priorPEMFiles {
+		priorKeys[pemFingerprint(pemFile.PublicKey.ValueString())] = pemFile.PublicKey.ValueString()
+	}
+
+	pemFiles := make([]pemFileModel, 0, len(apiPEMFiles))
+	for _, pemFile := range apiPEMFiles {
+		fingerprint := pemFingerprint(pemFile.PublicKey)
+		publicKey := pemFile.PublicKey
+		if priorKey, ok := priorKeys[fingerprint]; ok {
+			publicKey = priorKey
+		}
+		pemFiles = append(pemFiles, pemFileModel{
+			PEMFileID:      types.StringValue(pemFile.PEMFileID),
+			PublicKey:      types.StringValue(publicKey),
+			KeyFingerprint: types.StringValue(fingerprint),
+		})
+	}
+
+	set, setDiags := types.SetValueFrom(ctx, elemType, pemFiles)
+	diags.Append(setDiags...)
+	return set, diags
+}
+
 // Configure sets provider-level data for the resource.
 func (r *trustedTokenProfileResource) Configure(
 	_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse,
@@ -138,16 +226,19 @@ func (r *trustedTokenProfileResource) Configure(
 		return
 	}
 
-	client, ok := req.ProviderData.(*api.API)
+	data, ok := req.ProviderData.(*providerdata.ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *api.API (stytch-management-go client), got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.defaults = data.Defaults
+	r.readOnly = data.ReadOnly
+	r.protection = data.Protection
 }
 
 func (r *trustedTokenProfileResource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
@@ -209,7 +300,7 @@ func (r *trustedTokenProfileResource) upgradeTrustedTokenProfileStateV0ToV1(
 
 	profile := getResp.Profile
 	newState := trustedTokenProfileModel{
-		ID:              types.StringValue(fmt.Sprintf("%s.%s.%s", projectSlug, environmentSlug, profileID)),
+		ID:              types.StringValue(utils.FormatID(projectSlug, environmentSlug, profileID)),
 		ProjectSlug:     types.StringValue(projectSlug),
 		EnvironmentSlug: types.StringValue(environmentSlug),
 		ProfileID:       types.StringValue(profileID),
@@ -239,24 +330,10 @@ func (r *trustedTokenProfileResource) upgradeTrustedTokenProfileStateV0ToV1(
 		newState.AttributeMappingJSON = types.StringNull()
 	}
 
-	if len(profile.PEMFiles) > 0 {
-		pemFiles := make([]pemFileModel, 0, len(profile.PEMFiles))
-		for _, pemFile := range profile.PEMFiles {
-			pemFiles = append(pemFiles, pemFileModel{
-				PEMFileID: types.StringValue(pemFile.PEMFileID),
-				PublicKey: types.StringValue(pemFile.PublicKey),
-			})
-		}
-		newState.PEMFiles = types.SetValueMust(types.ObjectType{AttrTypes: pemFileModel{}.AttributeTypes()}, func() []attr.Value {
-			values := make([]attr.Value, len(pemFiles))
-			for i, pemFile := range pemFiles {
-				values[i] = types.ObjectValueMust(pemFile.AttributeTypes(), map[string]attr.Value{
-					"pem_file_id": pemFile.PEMFileID,
-					"public_key":  pemFile.PublicKey,
-				})
-			}
-			return values
-		}())
+	newState.PEMFiles, diags = pemFilesSetValue(ctx, profile.PEMFiles, newState.PEMFiles)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	diags = resp.State.Set(ctx, newState)
@@ -288,17 +365,19 @@ func (r *trustedTokenProfileResource) Schema(
 				},
 			},
 			"project_slug": schema.StringAttribute{
-				Required:    true,
-				Description: "The slug of the project to which the trusted token profile belongs.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The slug of the project to which the trusted token profile belongs. Defaults to the provider's `default_project_slug`.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"environment_slug": schema.StringAttribute{
-				Required:    true,
-				Description: "The slug of the environment to which the trusted token profile belongs.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The slug of the environment to which the trusted token profile belongs. Defaults to the provider's `default_environment_slug`.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"profile_id": schema.StringAttribute{
@@ -329,8 +408,10 @@ func (r *trustedTokenProfileResource) Schema(
 				Description: "The attribute mapping as a JSON object where keys and values are strings.",
 			},
 			"pem_files": schema.SetNestedAttribute{
-				Optional:    true,
-				Description: "Set of PEM files associated with the trusted token profile (required when public_key_type is PEM).",
+				Optional: true,
+				Description: "Set of PEM files associated with the trusted token profile (required when public_key_type is PEM). " +
+					"When the set changes, new PEM files are added before old ones are removed so that the profile always has at " +
+					"least one key to verify tokens with, and a change that would leave the profile with no PEM files is rejected.",
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"pem_file_id": schema.StringAttribute{
@@ -341,6 +422,11 @@ func (r *trustedTokenProfileResource) Schema(
 							Required:    true,
 							Description: "The public key content.",
 						},
+						"key_fingerprint": schema.StringAttribute{
+							Computed: true,
+							Description: "The hex-encoded SHA-256 fingerprint of the DER-encoded public key. PEM files are matched " +
+								"by fingerprint, so changes to whitespace or line wrapping in public_key do not rotate the key.",
+						},
 					},
 				},
 			},
@@ -371,7 +457,20 @@ func (r *trustedTokenProfileResource) Schema(
 	}
 }
 
-func (ttp *trustedTokenProfileModel) refreshFromTrustedTokenProfile(r trustedtokenprofiles.TrustedTokenProfile) diag.Diagnostics {
+// trustedTokenProfileIdentityAttributes are the attributes that identify a trusted token profile,
+// in the order of its import ID.
+var trustedTokenProfileIdentityAttributes = []string{"project_slug", "environment_slug", "profile_id"}
+
+// IdentitySchema defines the identity schema for the resource.
+func (r *trustedTokenProfileResource) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identitySchema(trustedTokenProfileIdentityAttributes...)
+}
+
+func (ttp *trustedTokenProfileModel) refreshFromTrustedTokenProfile(
+	ctx context.Context, r trustedtokenprofiles.TrustedTokenProfile,
+) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	ttp.ProfileID = types.StringValue(r.ProfileID)
@@ -399,101 +498,36 @@ func (ttp *trustedTokenProfileModel) refreshFromTrustedTokenProfile(r trustedtok
 		ttp.AttributeMappingJSON = types.StringNull()
 	}
 
-	if len(r.PEMFiles) > 0 {
-		ttp.PEMFiles = types.SetValueMust(types.ObjectType{AttrTypes: pemFileModel{}.AttributeTypes()},
-			func() []attr.Value {
-				values := make([]attr.Value, len(r.PEMFiles))
-				for i, pemFile := range r.PEMFiles {
-					values[i] = types.ObjectValueMust(pemFileModel{}.AttributeTypes(), map[string]attr.Value{
-						"pem_file_id": types.StringValue(pemFile.PEMFileID),
-						"public_key":  types.StringValue(pemFile.PublicKey),
-					})
-				}
-				return values
-			}())
-	} else {
-		ttp.PEMFiles = types.SetNull(types.ObjectType{AttrTypes: pemFileModel{}.AttributeTypes()})
-	}
+	pemFiles, pemDiags := pemFilesSetValue(ctx, r.PEMFiles, ttp.PEMFiles)
+	diags.Append(pemDiags...)
+	ttp.PEMFiles = pemFiles
 
 	return diags
 }
 
-// extractPEMFilesFromPlan extracts PEM file content from the plan.
-func extractPEMFilesFromPlan(plan trustedTokenProfileModel) ([]string, diag.Diagnostics) {
-	var diags diag.Diagnostics
-	var pemFiles []string
-
-	if !plan.PEMFiles.IsNull() && !plan.PEMFiles.IsUnknown() {
-		for _, elem := range plan.PEMFiles.Elements() {
-			if obj, ok := elem.(types.Object); ok {
-				if publicKeyAttr, ok := obj.Attributes()["public_key"]; ok {
-					if publicKey, ok := publicKeyAttr.(types.String); ok && !publicKey.IsNull() && !publicKey.IsUnknown() {
-						pemFiles = append(pemFiles, publicKey.ValueString())
-					}
-				} else {
-					diags.AddError("Invalid PEM file", "public_key is required")
-				}
-			}
-		}
-	}
-
-	return pemFiles, diags
-}
-
-// extractPEMFilesFromState extracts PEM file content and IDs from the state.
-func extractPEMFilesFromState(state trustedTokenProfileModel) (map[string]string, diag.Diagnostics) {
-	var diags diag.Diagnostics
-	pemFileMap := make(map[string]string) // public_key -> pem_file_id
-
-	if !state.PEMFiles.IsNull() && !state.PEMFiles.IsUnknown() {
-		for _, elem := range state.PEMFiles.Elements() {
-			if obj, ok := elem.(types.Object); ok {
-				attrs := obj.Attributes()
-
-				if publicKeyAttr, ok := attrs["public_key"]; ok {
-					if publicKey, ok := publicKeyAttr.(types.String); ok && !publicKey.IsNull() && !publicKey.IsUnknown() {
-						if pemFileIDAttr, ok := attrs["pem_file_id"]; ok {
-							if pemFileID, ok := pemFileIDAttr.(types.String); ok && !pemFileID.IsNull() && !pemFileID.IsUnknown() {
-								pemFileMap[publicKey.ValueString()] = pemFileID.ValueString()
-							}
-						}
-					}
-				} else {
-					diags.AddError("Invalid PEM file", "public_key is required")
-				}
-			}
-		}
-	}
-
-	return pemFileMap, diags
-}
-
-// extractPEMFilesFromPlanAsMap extracts PEM file content from the plan as a map for comparison.
-func extractPEMFilesFromPlanAsMap(plan trustedTokenProfileModel) (map[string]bool, diag.Diagnostics) {
-	var diags diag.Diagnostics
-	pemFileMap := make(map[string]bool)
-
-	if !plan.PEMFiles.IsNull() && !plan.PEMFiles.IsUnknown() {
-		for _, elem := range plan.PEMFiles.Elements() {
-			if obj, ok := elem.(types.Object); ok {
-				if publicKeyAttr, ok := obj.Attributes()["public_key"]; ok {
-					if publicKey, ok := publicKeyAttr.(types.String); ok && !publicKey.IsNull() && !publicKey.IsUnknown() {
-						pemFileMap[publicKey.ValueString()] = true
-					}
-				} else {
-					diags.AddError("Invalid PEM file", "public_key is required")
-				}
-			}
-		}
-	}
-
-	return pemFileMap, diags
+// ModifyPlan plans the provider's default project and environment slugs for the ones omitted
+// from the configuration and guards protected environments.
+func (r *trustedTokenProfileResource) ModifyPlan(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+) {
+	planDefaultProjectSlug(ctx, r.defaults, true, req, resp)
+	planDefaultEnvironmentSlug(ctx, r.defaults, true, req, resp)
+	guardProtectedEnvironment(ctx, r.protection, path.Root("environment_slug"), false, req, resp,
+		path.Root("project_slug"), path.Root("environment_slug"), path.Root("public_key_type"))
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *trustedTokenProfileResource) Create(
 	ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse,
 ) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_trusted_token_profiles", "Create", req.Plan)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, trustedTokenProfileIdentityAttributes...)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "create this resource") {
+		return
+	}
+
 	var plan trustedTokenProfileModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -516,12 +550,17 @@ func (r *trustedTokenProfileResource) Create(
 	}
 
 	// Extract PEM files from plan
-	pemFiles, diags := extractPEMFilesFromPlan(plan)
+	planPEMFiles, diags := pemFilesFromSet(ctx, plan.PEMFiles)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	pemFiles := make([]string, 0, len(planPEMFiles))
+	for _, pemFile := range planPEMFiles {
+		pemFiles = append(pemFiles, pemFile.PublicKey.ValueString())
+	}
+
 	createReq := trustedtokenprofiles.CreateRequest{
 		ProjectSlug:      plan.ProjectSlug.ValueString(),
 		EnvironmentSlug:  plan.EnvironmentSlug.ValueString(),
@@ -558,9 +597,9 @@ func (r *trustedTokenProfileResource) Create(
 	tflog.Info(ctx, "Created trusted token profile")
 
 	// Update the state with the response
-	diags = plan.refreshFromTrustedTokenProfile(createResp.Profile)
+	diags = plan.refreshFromTrustedTokenProfile(ctx, createResp.Profile)
 	resp.Diagnostics.Append(diags...)
-	plan.ID = types.StringValue(fmt.Sprintf("%s.%s.%s", plan.ProjectSlug.ValueString(), plan.EnvironmentSlug.ValueString(), plan.ProfileID.ValueString()))
+	plan.ID = types.StringValue(utils.FormatID(plan.ProjectSlug.ValueString(), plan.EnvironmentSlug.ValueString(), plan.ProfileID.ValueString()))
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -570,6 +609,10 @@ func (r *trustedTokenProfileResource) Create(
 func (r *trustedTokenProfileResource) Read(
 	ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse,
 ) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_trusted_token_profiles", "Read", req.State)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, trustedTokenProfileIdentityAttributes...)
+
 	var state trustedTokenProfileModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -588,6 +631,9 @@ func (r *trustedTokenProfileResource) Read(
 		ProfileID:       state.ProfileID.ValueString(),
 	})
 	if err != nil {
+		if removeIfNotFound(ctx, err, resp) {
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error reading trusted token profile",
 			fmt.Sprintf("Could not read trusted token profile: %s", err),
@@ -624,27 +670,10 @@ func (r *trustedTokenProfileResource) Read(
 	}
 
 	// Handle PEM files
-	if len(getResp.Profile.PEMFiles) > 0 {
-		pemFiles := make([]pemFileModel, 0, len(getResp.Profile.PEMFiles))
-		for _, pemFile := range getResp.Profile.PEMFiles {
-			pemFiles = append(pemFiles, pemFileModel{
-				PEMFileID: types.StringValue(pemFile.PEMFileID),
-				PublicKey: types.StringValue(pemFile.PublicKey),
-			})
-		}
-		state.PEMFiles = types.SetValueMust(types.ObjectType{AttrTypes: pemFileModel{}.AttributeTypes()},
-			func() []attr.Value {
-				values := make([]attr.Value, len(pemFiles))
-				for i, pemFile := range pemFiles {
-					values[i] = types.ObjectValueMust(pemFile.AttributeTypes(), map[string]attr.Value{
-						"pem_file_id": pemFile.PEMFileID,
-						"public_key":  pemFile.PublicKey,
-					})
-				}
-				return values
-			}())
-	} else {
-		state.PEMFiles = types.SetNull(types.ObjectType{AttrTypes: pemFileModel{}.AttributeTypes()})
+	state.PEMFiles, diags = pemFilesSetValue(ctx, getResp.Profile.PEMFiles, state.PEMFiles)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	diags = resp.State.Set(ctx, state)
@@ -655,6 +684,14 @@ func (r *trustedTokenProfileResource) Read(
 func (r *trustedTokenProfileResource) Update(
 	ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse,
 ) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_trusted_token_profiles", "Update", req.Plan)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+	defer setIdentity(ctx, &resp.State, resp.Identity, &resp.Diagnostics, trustedTokenProfileIdentityAttributes...)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "update this resource") {
+		return
+	}
+
 	var plan, state trustedTokenProfileModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -673,6 +710,59 @@ func (r *trustedTokenProfileResource) Update(
 	ctx = tflog.SetField(ctx, "profile_id", plan.ProfileID.ValueString())
 	tflog.Info(ctx, "Updating trusted token profile")
 
+	// Work out the PEM file rotation up front so that an invalid change is rejected before
+	// anything is modified. PEM files are matched by fingerprint rather than by their raw content.
+	statePEMFiles, diags := pemFilesFromSet(ctx, state.PEMFiles)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planPEMFiles, diags := pemFilesFromSet(ctx, plan.PEMFiles)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	currentPEMs := make(map[string]string, len(statePEMFiles)) // key_fingerprint -> pem_file_id
+	for _, pemFile := range statePEMFiles {
+		if !pemFile.PEMFileID.IsNull() && !pemFile.PEMFileID.IsUnknown() {
+			currentPEMs[pemFingerprint(pemFile.PublicKey.ValueString())] = pemFile.PEMFileID.ValueString()
+		}
+	}
+
+	desiredPEMs := make(map[string]string, len(planPEMFiles)) // key_fingerprint -> public_key
+	for _, pemFile := range planPEMFiles {
+		desiredPEMs[pemFingerprint(pemFile.PublicKey.ValueString())] = pemFile.PublicKey.ValueString()
+	}
+
+	if plan.PublicKeyType.ValueString() == string(trustedtokenprofiles.PublicKeyTypePem) &&
+		len(currentPEMs) > 0 && len(desiredPEMs) == 0 {
+		resp.Diagnostics.AddError(
+			"Invalid PEM file rotation",
+			"A PEM trusted token profile must always have at least one PEM file. Add the replacement "+
+				"public key to pem_files before removing the last existing one.",
+		)
+		return
+	}
+
+	// Sort by fingerprint so that rotations are applied in a deterministic order.
+	var pemFilesToAdd []string
+	for fingerprint := range desiredPEMs {
+		if _, exists := currentPEMs[fingerprint]; !exists {
+			pemFilesToAdd = append(pemFilesToAdd, fingerprint)
+		}
+	}
+	sort.Strings(pemFilesToAdd)
+
+	var pemFilesToDelete []string
+	for fingerprint := range currentPEMs {
+		if _, exists := desiredPEMs[fingerprint]; !exists {
+			pemFilesToDelete = append(pemFilesToDelete, fingerprint)
+		}
+	}
+	sort.Strings(pemFilesToDelete)
+
 	var attributeMapping map[string]any
 	if !plan.AttributeMappingJSON.IsNull() && !plan.AttributeMappingJSON.IsUnknown() {
 		jsonStr := plan.AttributeMappingJSON.ValueString()
@@ -713,45 +803,18 @@ func (r *trustedTokenProfileResource) Update(
 		return
 	}
 
-	// Handle PEM files - compare current state with desired plan
-	currentPEMs, diags := extractPEMFilesFromState(state)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	desiredPEMs, diags := extractPEMFilesFromPlanAsMap(plan)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	// Find PEM files to add (in plan but not in state)
-	var pemFilesToAdd []string
-	for desiredPEM := range desiredPEMs {
-		if _, exists := currentPEMs[desiredPEM]; !exists {
-			pemFilesToAdd = append(pemFilesToAdd, desiredPEM)
-		}
-	}
-
-	// Find PEM file IDs to delete (in state but not in plan)
-	var pemFileIDsToDelete []string
-	for currentPEM, pemFileID := range currentPEMs {
-		if !desiredPEMs[currentPEM] {
-			pemFileIDsToDelete = append(pemFileIDsToDelete, pemFileID)
-		}
-	}
-
-	// Add new PEM files
-	for _, pemContent := range pemFilesToAdd {
+	// Add new PEM files before removing old ones so that the profile always has a key that can
+	// verify tokens during a rotation.
+	for _, fingerprint := range pemFilesToAdd {
 		tflog.Info(ctx, "Adding PEM file", map[string]interface{}{
-			"profile_id": plan.ProfileID.ValueString(),
+			"profile_id":      plan.ProfileID.ValueString(),
+			"key_fingerprint": fingerprint,
 		})
 		_, err := r.client.TrustedTokenProfiles.CreatePEMFile(ctx, trustedtokenprofiles.CreatePEMFileRequest{
 			ProjectSlug:     plan.ProjectSlug.ValueString(),
 			EnvironmentSlug: plan.EnvironmentSlug.ValueString(),
 			ProfileID:       plan.ProfileID.ValueString(),
-			PublicKey:       pemContent,
+			PublicKey:       desiredPEMs[fingerprint],
 		})
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -763,10 +826,12 @@ func (r *trustedTokenProfileResource) Update(
 	}
 
 	// Remove old PEM files
-	for _, pemFileID := range pemFileIDsToDelete {
+	for _, fingerprint := range pemFilesToDelete {
+		pemFileID := currentPEMs[fingerprint]
 		tflog.Info(ctx, "Removing PEM file", map[string]interface{}{
-			"profile_id":  plan.ProfileID.ValueString(),
-			"pem_file_id": pemFileID,
+			"profile_id":      plan.ProfileID.ValueString(),
+			"pem_file_id":     pemFileID,
+			"key_fingerprint": fingerprint,
 		})
 		_, err := r.client.TrustedTokenProfiles.DeletePEMFile(ctx, trustedtokenprofiles.DeletePEMFileRequest{
 			ProjectSlug:     plan.ProjectSlug.ValueString(),
@@ -800,9 +865,9 @@ func (r *trustedTokenProfileResource) Update(
 	}
 
 	// Update the state with the final response
-	diags = plan.refreshFromTrustedTokenProfile(getResp.Profile)
+	diags = plan.refreshFromTrustedTokenProfile(ctx, getResp.Profile)
 	resp.Diagnostics.Append(diags...)
-	plan.ID = types.StringValue(fmt.Sprintf("%s.%s.%s", plan.ProjectSlug.ValueString(), plan.EnvironmentSlug.ValueString(), plan.ProfileID.ValueString()))
+	plan.ID = types.StringValue(utils.FormatID(plan.ProjectSlug.ValueString(), plan.EnvironmentSlug.ValueString(), plan.ProfileID.ValueString()))
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -812,6 +877,13 @@ func (r *trustedTokenProfileResource) Update(
 func (r *trustedTokenProfileResource) Delete(
 	ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse,
 ) {
+	ctx, span := tracing.StartResourceSpan(ctx, "stytch_trusted_token_profiles", "Delete", req.State)
+	defer tracing.EndSpan(span, &resp.Diagnostics)
+
+	if !providerdata.CheckWritable(r.readOnly, &resp.Diagnostics, "delete this resource") {
+		return
+	}
+
 	var state trustedTokenProfileModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -849,16 +921,12 @@ func (r *trustedTokenProfileResource) ImportState(
 	tflog.Info(ctx, "Importing trusted token profile")
 
 	// Import ID format: project_slug.environment_slug.profile_id
-	parts := strings.Split(req.ID, ".")
-	if len(parts) != 3 {
-		resp.Diagnostics.AddError(
-			"Invalid import ID format",
-			fmt.Sprintf("Expected import ID format: project_slug.environment_slug.profile_id, got: %s", req.ID),
-		)
+	parts, ok := importParts(ctx, req, &resp.Diagnostics, trustedTokenProfileIdentityAttributes...)
+	if !ok {
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), utils.FormatID(parts...))...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_slug"), parts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_slug"), parts[1])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("profile_id"), parts[2])...)
@@ -3,6 +3,8 @@ package resources_test
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -267,6 +269,7 @@ func TestAccTrustedTokenProfileResource(t *testing.T) {
 
 			if len(tc.Initial.PemFiles) > 0 {
 				initialChecks = append(initialChecks, resource.TestCheckResourceAttr(resourceName, "pem_files.#", fmt.Sprintf("%d", len(tc.Initial.PemFiles))))
+				initialChecks = append(initialChecks, resource.TestCheckResourceAttrSet(resourceName, "pem_files.0.key_fingerprint"))
 			}
 
 			// Build update Terraform configuration
@@ -342,6 +345,7 @@ func TestAccTrustedTokenProfileResource(t *testing.T) {
 
 			if len(tc.Update.PemFiles) > 0 {
 				updateChecks = append(updateChecks, resource.TestCheckResourceAttr(resourceName, "pem_files.#", fmt.Sprintf("%d", len(tc.Update.PemFiles))))
+				updateChecks = append(updateChecks, resource.TestCheckResourceAttrSet(resourceName, "pem_files.0.key_fingerprint"))
 			}
 
 			// Build delete Terraform configuration.
@@ -378,6 +382,78 @@ func TestAccTrustedTokenProfileResource(t *testing.T) {
 	}
 }
 
+// TestAccTrustedTokenProfileResourcePEMRotation checks that PEM files are matched by fingerprint
+// and that a rotation can never leave a PEM profile without any keys.
+func TestAccTrustedTokenProfileResourcePEMRotation(t *testing.T) {
+	const resourceName = "stytch_trusted_token_profiles.test_profile"
+
+	firstKey := "-----BEGIN PUBLIC KEY-----\nFIRSTONEMIIBIjANBgkhhkiG9w0BAQEEOCAQ8AMIIBCgKCAQEA4f5wg5l2hKsTeNem/V41\nfGnJm6gOdrj8ym3rFkEjWT2btYK36hY+c2QKfPU5O7w=\n-----END PUBLIC KEY-----"
+	// The same key with different line wrapping.
+	firstKeyRewrapped := strings.Replace(firstKey, "V41\nfGnJ", "V41fGnJ", 1)
+	secondKey := "-----BEGIN PUBLIC KEY-----\nSECONDONEMIIBIjANBgkhhkiG9w0BAQEEOCAQ8AMIIBCgKCAQEA4f5wg5l2hKsTeNem/V41\nfGnJm6gOdrj8ym3rFkEjWT2btYK36hY+c2QKfPU5O7w=\n-----END PUBLIC KEY-----"
+
+	projectConfig := testutil.ConsumerProjectConfig + testutil.EnvironmentResource(testutil.EnvironmentResourceArgs{
+		ProjectSlug: "stytch_project.test.project_slug",
+		Name:        "Test Environment",
+	})
+
+	resourceConfig := func(pemFiles ...string) string {
+		return projectConfig + `
+			resource "stytch_trusted_token_profiles" "test_profile" {
+				project_slug     = stytch_project.test.project_slug
+				environment_slug = stytch_environment.test.environment_slug
+				name             = "Test Profile PEM Rotation"
+				audience         = "test-profile-pem-rotation"
+				issuer           = "https://test-profile-pem-rotation-issuer.com"
+				public_key_type  = "PEM"
+		` + pemFileConfigString(t, pemFiles) + `
+			}
+		`
+	}
+
+	var pemFileID string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testutil.ProviderConfig + resourceConfig(firstKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "pem_files.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "pem_files.0.key_fingerprint"),
+					resource.TestCheckResourceAttrWith(resourceName, "pem_files.0.pem_file_id", func(value string) error {
+						pemFileID = value
+						return nil
+					}),
+				),
+			},
+			{
+				// Re-wrapping the key keeps the same PEM file.
+				Config: testutil.ProviderConfig + resourceConfig(firstKeyRewrapped),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "pem_files.#", "1"),
+					resource.TestCheckResourceAttrWith(resourceName, "pem_files.0.pem_file_id", func(value string) error {
+						if value != pemFileID {
+							return fmt.Errorf("expected PEM file %s to be kept, got %s", pemFileID, value)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				// Rotate to a new key.
+				Config: testutil.ProviderConfig + resourceConfig(secondKey),
+				Check:  resource.TestCheckResourceAttr(resourceName, "pem_files.#", "1"),
+			},
+			{
+				// Removing the last key is rejected.
+				Config:      testutil.ProviderConfig + resourceConfig(),
+				ExpectError: regexp.MustCompile("must always have at least one PEM file"),
+			},
+		},
+	})
+}
+
 func TestAccTrustedTokenProfileResourceStateUpgrade(t *testing.T) {
 	v1Config := testutil.V1ConsumerProjectConfig + `
 resource "stytch_trusted_token_profiles" "simple_example" {
@@ -0,0 +1,134 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/providerdata"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/utils"
+)
+
+// verticalAttribute is an attribute that can only be configured for projects of one vertical.
+type verticalAttribute struct {
+	path     path.Path
+	vertical projects.Vertical
+}
+
+// checkProjectVertical fails the plan of a resource that only applies to projects of
+// resourceVertical, unless it is empty, if the resource's project has another vertical. It also
+// fails the plan if any of attributes is configured while the project has another vertical than
+// the attribute's. The project's vertical is only looked up when there is something to check and
+// project_slug is known, and nothing is checked for projects that don't exist yet, which the
+// Stytch API checks when they are created.
+func checkProjectVertical(
+	ctx context.Context, verticals *providerdata.Verticals, typeName string, resourceVertical projects.Vertical,
+	req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, attributes ...verticalAttribute,
+) {
+	// Nothing to check on destroy.
+	if resp.Diagnostics.HasError() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	configured := configuredVerticalAttributes(ctx, req, resp, attributes)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if resourceVertical == "" && len(configured) == 0 {
+		return
+	}
+
+	var projectSlug types.String
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("project_slug"), &projectSlug)...)
+	if resp.Diagnostics.HasError() || projectSlug.IsUnknown() || projectSlug.IsNull() {
+		return
+	}
+
+	vertical, err := verticals.Get(ctx, projectSlug.ValueString())
+	if err != nil {
+		if !utils.IsNotFound(err) {
+			resp.Diagnostics.AddWarning("Failed to get project for vertical check", err.Error())
+		}
+		return
+	}
+	if vertical == "" {
+		return
+	}
+
+	addVerticalErrors(resp, typeName, fmt.Sprintf("project %q", projectSlug.ValueString()), vertical,
+		resourceVertical, configured)
+}
+
+// configuredVerticalAttributes returns the attributes that are configured.
+func configuredVerticalAttributes(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, attributes []verticalAttribute,
+) []verticalAttribute {
+	configured := make([]verticalAttribute, 0, len(attributes))
+	for _, attribute := range attributes {
+		var value attr.Value
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, attribute.path, &value)...)
+		if resp.Diagnostics.HasError() {
+			return nil
+		}
+		if !value.IsNull() {
+			configured = append(configured, attribute)
+		}
+	}
+	return configured
+}
+
+// addVerticalErrors adds an error to resp for the resource if resourceVertical is set and differs
+// from vertical, the vertical of the resource's project, and for each of the configured attributes
+// whose vertical differs from it. project describes the project in the errors.
+func addVerticalErrors(
+	resp *resource.ModifyPlanResponse, typeName, project string, vertical, resourceVertical projects.Vertical,
+	configured []verticalAttribute,
+) {
+	if resourceVertical != "" && vertical != resourceVertical {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Invalid resource for %s project", vertical),
+			fmt.Sprintf("%s can only be used with %s projects, but %s is a %s project.",
+				typeName, resourceVertical, project, vertical),
+		)
+		return
+	}
+	for _, attribute := range configured {
+		if attribute.vertical == vertical {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			attribute.path,
+			fmt.Sprintf("Invalid attribute for %s project", vertical),
+			fmt.Sprintf("%s can only be configured for %s projects, but %s is a %s project.",
+				attribute.path, attribute.vertical, project, vertical),
+		)
+	}
+}
+
+// checkPlannedVertical fails the plan of a project if any of attributes is configured while the
+// planned vertical of the project differs from the attribute's.
+func checkPlannedVertical(
+	ctx context.Context, typeName string, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+	attributes ...verticalAttribute,
+) {
+	// Nothing to check on destroy.
+	if resp.Diagnostics.HasError() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var vertical types.String
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("vertical"), &vertical)...)
+	if resp.Diagnostics.HasError() || vertical.IsUnknown() || vertical.IsNull() {
+		return
+	}
+
+	configured := configuredVerticalAttributes(ctx, req, resp, attributes)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	addVerticalErrors(resp, typeName, "the project", projects.Vertical(vertical.ValueString()), "", configured)
+}
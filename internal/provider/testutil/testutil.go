@@ -2,9 +2,11 @@ package testutil
 
 import (
 	"fmt"
+	"os"
 	"regexp"
 	"testing"
 
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
 	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -30,15 +32,24 @@ var TestAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 
 var ConsumerProjectConfig = ProjectResource(
 	ProjectResourceArgs{
-		Name:     "test-consumer",
-		Vertical: projects.VerticalConsumer,
+		Name:               "test-consumer",
+		Vertical:           projects.VerticalConsumer,
+		DeletionProtection: Unprotected(),
 	})
 
 var B2BProjectConfig = ProjectResource(ProjectResourceArgs{
-	Name:     "test-b2b",
-	Vertical: projects.VerticalB2B,
+	Name:               "test-b2b",
+	Vertical:           projects.VerticalB2B,
+	DeletionProtection: Unprotected(),
 })
 
+// Unprotected returns the DeletionProtection of a project that is destroyed when its test ends,
+// which deletion protection would otherwise refuse.
+func Unprotected() *bool {
+	unprotected := false
+	return &unprotected
+}
+
 // V1 provider project configs for state upgrade tests (uses live_project_id)
 const V1ConsumerProjectConfig = `
 resource "stytch_project" "test" {
@@ -58,6 +69,7 @@ type ProjectResourceArgs struct {
 	Name                         string
 	Vertical                     projects.Vertical
 	ProjectSlug                  *string
+	DeletionProtection           *bool
 	LiveEnvironmentSlug          *string
 	LiveEnvironmentName          *string
 	CrossOrgPasswordsEnabled     *bool
@@ -75,6 +87,7 @@ type EnvironmentResourceArgs struct {
 	ProjectSlug                  string
 	EnvironmentSlug              *string
 	Name                         string
+	Type                         *string
 	CrossOrgPasswordsEnabled     *bool
 	UserImpersonationEnabled     *bool
 	ZeroDowntimeSessionMigration *string
@@ -95,6 +108,9 @@ resource "stytch_project" "test" {
 	if args.ProjectSlug != nil {
 		config += fmt.Sprintf("\n  project_slug = \"%s\"", *args.ProjectSlug)
 	}
+	if args.DeletionProtection != nil {
+		config += fmt.Sprintf("\n  deletion_protection = %t", *args.DeletionProtection)
+	}
 
 	config += "\n  live_environment = {"
 	if args.LiveEnvironmentSlug != nil {
@@ -148,6 +164,9 @@ resource "stytch_environment" "test" {
 	if args.EnvironmentSlug != nil {
 		config += fmt.Sprintf("\n  environment_slug = \"%s\"", *args.EnvironmentSlug)
 	}
+	if args.Type != nil {
+		config += fmt.Sprintf("\n  type = \"%s\"", *args.Type)
+	}
 	if args.CrossOrgPasswordsEnabled != nil {
 		config += fmt.Sprintf("\n  cross_org_passwords_enabled = %t", *args.CrossOrgPasswordsEnabled)
 	}
@@ -222,6 +241,12 @@ func (e *ErrorCase) AssertErrorWith(t *testing.T, errRegex *regexp.Regexp) {
 	})
 }
 
+// Client returns a Stytch management client for the workspace key in the STYTCH_ environment
+// variables, for tests that change the workspace outside of Terraform.
+func Client() *api.API {
+	return api.NewClient(os.Getenv("STYTCH_WORKSPACE_KEY_ID"), os.Getenv("STYTCH_WORKSPACE_KEY_SECRET"))
+}
+
 // TestCheckResourceDeleted checks that a resource has been deleted from the state.
 func TestCheckResourceDeleted(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
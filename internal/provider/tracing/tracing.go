@@ -0,0 +1,134 @@
+// Package tracing exports OpenTelemetry traces of provider operations when an OTLP endpoint is
+// configured through the standard OTEL_EXPORTER_OTLP_ENDPOINT or
+// OTEL_EXPORTER_OTLP_TRACES_ENDPOINT environment variables.
+package tracing
+
+import (
+	"context"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the name of the tracer that provider spans are created with.
+const TracerName = "github.com/stytchauth/terraform-provider-stytch"
+
+// Span attribute keys shared by resource and API call spans.
+const (
+	ResourceTypeKey    = attribute.Key("stytch.resource_type")
+	ProjectSlugKey     = attribute.Key("stytch.project_slug")
+	EnvironmentSlugKey = attribute.Key("stytch.environment_slug")
+)
+
+// Enabled reports whether an OTLP endpoint for traces is configured.
+func Enabled() bool {
+	return os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" || os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != ""
+}
+
+// Setup installs a global tracer provider that exports spans over OTLP/HTTP if an endpoint is
+// configured, and otherwise leaves the default no-op tracer provider in place. The exporter is
+// configured by the standard OTEL_EXPORTER_OTLP_* environment variables. The returned function
+// flushes the remaining spans and must be called before the provider exits.
+func Setup(ctx context.Context, version string) (func(context.Context) error, error) {
+	if !Enabled() {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, err := sdkresource.Merge(
+		sdkresource.Default(),
+		sdkresource.NewSchemaless(
+			semconv.ServiceName("terraform-provider-stytch"),
+			semconv.ServiceVersion(version),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// Tracer returns the tracer that provider spans are created with.
+func Tracer() trace.Tracer {
+	return otel.Tracer(TracerName)
+}
+
+// attributeGetter is implemented by tfsdk.Plan and tfsdk.State.
+type attributeGetter interface {
+	GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics
+}
+
+// resourceAttributesKey is the context key of the attributes that StartResourceSpan tags the span
+// of a resource operation with.
+type resourceAttributesKey struct{}
+
+// StartResourceSpan starts the span of a resource operation, such as "Create", tagged with the
+// resource type and the project and environment slugs found in data, which is the plan or state
+// the operation works on. The returned context also carries these attributes for the spans of the
+// API calls the operation makes, which ResourceAttributes returns.
+func StartResourceSpan(
+	ctx context.Context, resourceType, operation string, data attributeGetter,
+) (context.Context, trace.Span) {
+	attrs := []attribute.KeyValue{ResourceTypeKey.String(resourceType)}
+	if slug, ok := knownString(ctx, data, path.Root("project_slug")); ok {
+		attrs = append(attrs, ProjectSlugKey.String(slug))
+	}
+	if slug, ok := knownString(ctx, data, path.Root("environment_slug")); ok {
+		attrs = append(attrs, EnvironmentSlugKey.String(slug))
+	}
+	ctx = context.WithValue(ctx, resourceAttributesKey{}, attrs)
+	return Tracer().Start(ctx, resourceType+" "+operation, trace.WithAttributes(attrs...))
+}
+
+// ResourceAttributes returns the resource type and slugs of the resource operation that ctx was
+// returned for by StartResourceSpan, or nil outside of resource operations.
+func ResourceAttributes(ctx context.Context) []attribute.KeyValue {
+	attrs, _ := ctx.Value(resourceAttributesKey{}).([]attribute.KeyValue)
+	return attrs
+}
+
+// EndSpan ends span, marking it as failed if diags has errors. It is meant to be deferred with the
+// operation's response diagnostics.
+func EndSpan(span trace.Span, diags *diag.Diagnostics) {
+	if diags.HasError() {
+		for _, d := range diags.Errors() {
+			span.AddEvent("error", trace.WithAttributes(
+				attribute.String("summary", d.Summary()),
+				attribute.String("detail", d.Detail()),
+			))
+		}
+		span.SetStatus(codes.Error, diags.Errors()[0].Summary())
+	}
+	span.End()
+}
+
+// knownString returns the value of a string attribute of data, if it exists and is known.
+func knownString(ctx context.Context, data attributeGetter, attrPath path.Path) (string, bool) {
+	var value types.String
+	if diags := data.GetAttribute(ctx, attrPath, &value); diags.HasError() {
+		return "", false
+	}
+	if value.IsNull() || value.IsUnknown() {
+		return "", false
+	}
+	return value.ValueString(), true
+}
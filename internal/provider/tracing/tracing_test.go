@@ -0,0 +1,64 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// fakeData is an attributeGetter holding string attributes at the root.
+type fakeData map[string]types.String
+
+func (d fakeData) GetAttribute(_ context.Context, attrPath path.Path, target interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	value, ok := d[attrPath.String()]
+	if !ok {
+		diags.AddAttributeError(attrPath, "Missing attribute", "")
+		return diags
+	}
+	*target.(*types.String) = value
+	return diags
+}
+
+func TestResourceSpan(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	data := fakeData{
+		"project_slug":     types.StringValue("my-project"),
+		"environment_slug": types.StringUnknown(),
+	}
+	_, span := StartResourceSpan(context.Background(), "stytch_redirect_url", "Create", data)
+	var diags diag.Diagnostics
+	diags.AddError("Error creating redirect URL", "boom")
+	EndSpan(span, &diags)
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span, got %d", len(spans))
+	}
+	if spans[0].Name() != "stytch_redirect_url Create" {
+		t.Errorf("unexpected span name %q", spans[0].Name())
+	}
+	attrs := attribute.NewSet(spans[0].Attributes()...)
+	if v, _ := attrs.Value(ResourceTypeKey); v.AsString() != "stytch_redirect_url" {
+		t.Errorf("unexpected resource type %q", v.AsString())
+	}
+	if v, _ := attrs.Value(ProjectSlugKey); v.AsString() != "my-project" {
+		t.Errorf("unexpected project slug %q", v.AsString())
+	}
+	if attrs.HasValue(EnvironmentSlugKey) {
+		t.Errorf("expected the unknown environment slug to be left out")
+	}
+	if spans[0].Status().Code != codes.Error || spans[0].Status().Description != "Error creating redirect URL" {
+		t.Errorf("unexpected span status %v", spans[0].Status())
+	}
+}
@@ -0,0 +1,63 @@
+package transport
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/url"
+)
+
+// BaseTransportConfig configures the HTTP transport that requests to the Stytch API are sent over.
+type BaseTransportConfig struct {
+	// ProxyURL is the proxy to send requests through. If nil, the proxy is taken from the
+	// HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables.
+	ProxyURL *url.URL
+	// CACertsPEM holds PEM-encoded CA certificates to trust in addition to the system ones, such as
+	// the certificate of a TLS-intercepting proxy.
+	CACertsPEM [][]byte
+	// InsecureSkipVerify disables verification of the server's TLS certificate. It should only be
+	// used against local test servers.
+	InsecureSkipVerify bool
+}
+
+// InvalidCACertError is returned by NewBaseTransport when an entry of CACertsPEM holds no valid
+// PEM-encoded certificate.
+type InvalidCACertError struct {
+	// Index is the index of the invalid entry in CACertsPEM.
+	Index int
+}
+
+func (e *InvalidCACertError) Error() string {
+	return "no valid PEM-encoded certificates found in CA bundle"
+}
+
+// NewBaseTransport returns a copy of http.DefaultTransport configured with cfg.
+func NewBaseTransport(cfg BaseTransportConfig) (*http.Transport, error) {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+
+	if cfg.ProxyURL != nil {
+		t.Proxy = http.ProxyURL(cfg.ProxyURL)
+	}
+
+	if len(cfg.CACertsPEM) > 0 || cfg.InsecureSkipVerify {
+		if t.TLSClientConfig == nil {
+			t.TLSClientConfig = &tls.Config{}
+		}
+		t.TLSClientConfig.InsecureSkipVerify = cfg.InsecureSkipVerify
+	}
+
+	if len(cfg.CACertsPEM) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		for i, pem := range cfg.CACertsPEM {
+			if !pool.AppendCertsFromPEM(pem) {
+				return nil, &InvalidCACertError{Index: i}
+			}
+		}
+		t.TLSClientConfig.RootCAs = pool
+	}
+
+	return t, nil
+}
@@ -0,0 +1,111 @@
+package transport_test
+
+import (
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/transport"
+)
+
+func TestBaseTransportCACerts(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	serverCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	for _, tc := range []struct {
+		name        string
+		config      transport.BaseTransportConfig
+		expectError bool
+	}{
+		{
+			name:        "untrusted certificate",
+			config:      transport.BaseTransportConfig{},
+			expectError: true,
+		},
+		{
+			name:   "trusted CA certificate",
+			config: transport.BaseTransportConfig{CACertsPEM: [][]byte{serverCert}},
+		},
+		{
+			name:   "insecure skip verify",
+			config: transport.BaseTransportConfig{InsecureSkipVerify: true},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			base, err := transport.NewBaseTransport(tc.config)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			resp, err := (&http.Client{Transport: base}).Get(server.URL)
+			if tc.expectError {
+				if err == nil {
+					resp.Body.Close()
+					t.Fatal("expected a certificate verification error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			resp.Body.Close()
+		})
+	}
+}
+
+func TestBaseTransportInvalidCACerts(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	serverCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	_, err := transport.NewBaseTransport(transport.BaseTransportConfig{
+		CACertsPEM: [][]byte{serverCert, []byte("not a certificate")},
+	})
+	var invalidCACert *transport.InvalidCACertError
+	if !errors.As(err, &invalidCACert) {
+		t.Fatalf("expected an InvalidCACertError for an invalid CA bundle, got %v", err)
+	}
+	if invalidCACert.Index != 1 {
+		t.Errorf("expected the invalid CA bundle at index 1, got %d", invalidCACert.Index)
+	}
+}
+
+func TestBaseTransportProxy(t *testing.T) {
+	var proxied atomic.Int32
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied.Add(1)
+		// Requests sent through a proxy use the absolute URL of the target.
+		if r.URL.Host != "api.example.com" {
+			t.Errorf("expected request for api.example.com, got %s", r.URL.Host)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer proxy.Close()
+
+	proxyURL, err := url.Parse(proxy.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	base, err := transport.NewBaseTransport(transport.BaseTransportConfig{ProxyURL: proxyURL})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	resp, err := (&http.Client{Transport: base}).Get("http://api.example.com/v1/projects")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if proxied.Load() != 1 {
+		t.Errorf("expected 1 request through the proxy, got %d", proxied.Load())
+	}
+}
@@ -0,0 +1,170 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// LogSubsystem is the name of the tflog subsystem that Stytch API requests are logged to. Its level
+// follows TF_LOG and TF_LOG_PROVIDER, and can be set separately with the
+// TF_LOG_PROVIDER_STYTCH_API environment variable.
+const LogSubsystem = "api"
+
+// maskedValue replaces the values of sensitive fields in logged bodies.
+const maskedValue = "***"
+
+// SensitiveFieldKeys are the JSON field names whose values are masked in logged request and
+// response bodies. Any field whose name ends in one of them is masked, so "secret" also masks
+// "workspace_key_secret" and "client_secret".
+var SensitiveFieldKeys = []string{
+	"secret",
+	"api_key",
+	"password",
+	"public_key",
+	"private_key",
+	"token",
+}
+
+// LoggingTransport is an http.RoundTripper that logs each request to the Stytch API. The method,
+// path, status code, latency and Stytch request ID are logged at DEBUG, and the request and
+// response bodies at TRACE with the values of sensitive fields masked. Headers are never logged,
+// since they carry the workspace key.
+type LoggingTransport struct {
+	base http.RoundTripper
+}
+
+// NewLoggingTransport wraps base so that requests are logged. If base is nil,
+// http.DefaultTransport is used.
+func NewLoggingTransport(base http.RoundTripper) *LoggingTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &LoggingTransport{base: base}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *LoggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := tflog.NewSubsystem(req.Context(), LogSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_STYTCH_API"))
+	ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, LogSubsystem, "workspace_key_secret")
+	ctx = tflog.SubsystemSetField(ctx, LogSubsystem, "method", req.Method)
+	ctx = tflog.SubsystemSetField(ctx, LogSubsystem, "path", req.URL.Path)
+
+	if req.Body != nil && req.GetBody != nil {
+		// GetBody returns a copy of the body, so the one that is sent is left unread.
+		if body, err := req.GetBody(); err == nil {
+			logBody(ctx, "Stytch API request body", body)
+		}
+	}
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	latency := time.Since(start)
+	if err != nil {
+		tflog.SubsystemDebug(ctx, LogSubsystem, "Stytch API request failed", map[string]interface{}{
+			"latency": latency.String(),
+			"error":   err.Error(),
+		})
+		return nil, err
+	}
+
+	// Read the whole body so that the request ID can be taken from it and the body logged, then
+	// hand the response on with a copy.
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		tflog.SubsystemDebug(ctx, LogSubsystem, "Failed to read Stytch API response body", map[string]interface{}{
+			"latency":     latency.String(),
+			"status_code": resp.StatusCode,
+			"error":       err.Error(),
+		})
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	fields := map[string]interface{}{
+		"status_code": resp.StatusCode,
+		"latency":     latency.String(),
+	}
+	if requestID := responseRequestID(resp.Header, body); requestID != "" {
+		fields["request_id"] = requestID
+	}
+	tflog.SubsystemDebug(ctx, LogSubsystem, "Stytch API request", fields)
+	logBody(ctx, "Stytch API response body", io.NopCloser(bytes.NewReader(body)))
+	return resp, nil
+}
+
+// logBody logs a request or response body at TRACE, with sensitive fields masked.
+func logBody(ctx context.Context, msg string, body io.ReadCloser) {
+	defer body.Close()
+	raw, err := io.ReadAll(body)
+	if err != nil || len(raw) == 0 {
+		return
+	}
+	tflog.SubsystemTrace(ctx, LogSubsystem, msg, map[string]interface{}{
+		"body": MaskBody(raw),
+	})
+}
+
+// responseRequestID returns the Stytch request ID of a response, which is in the request_id field
+// of the body, or in the X-Request-Id header for responses without a JSON body.
+func responseRequestID(header http.Header, body []byte) string {
+	var parsed struct {
+		RequestID string `json:"request_id"`
+	}
+	if json.Unmarshal(body, &parsed) == nil && parsed.RequestID != "" {
+		return parsed.RequestID
+	}
+	return header.Get("X-Request-Id")
+}
+
+// MaskBody returns body with the values of the fields named in SensitiveFieldKeys masked. Bodies
+// that aren't JSON are not logged, since there is no telling what they contain.
+func MaskBody(body []byte) string {
+	var parsed interface{}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return "<non-JSON body omitted>"
+	}
+	masked, err := json.Marshal(maskValue(parsed))
+	if err != nil {
+		return "<non-JSON body omitted>"
+	}
+	return string(masked)
+}
+
+func maskValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if isSensitiveFieldKey(key) && field != nil {
+				v[key] = maskedValue
+			} else {
+				v[key] = maskValue(field)
+			}
+		}
+		return v
+	case []interface{}:
+		for i, elem := range v {
+			v[i] = maskValue(elem)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+func isSensitiveFieldKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, sensitive := range SensitiveFieldKeys {
+		if strings.HasSuffix(key, sensitive) {
+			return true
+		}
+	}
+	return false
+}
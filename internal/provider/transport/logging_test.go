@@ -0,0 +1,98 @@
+package transport_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/transport"
+)
+
+func TestMaskBody(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		body     string
+		expected string
+	}{
+		{
+			name:     "no sensitive fields",
+			body:     `{"project_slug":"my-project","url":"https://example.com"}`,
+			expected: `{"project_slug":"my-project","url":"https://example.com"}`,
+		},
+		{
+			name:     "sensitive fields",
+			body:     `{"secret":"s","secret_id":"id","client_secret":"s","password":"p","api_key":"k"}`,
+			expected: `{"api_key":"***","client_secret":"***","password":"***","secret":"***","secret_id":"id"}`,
+		},
+		{
+			name:     "nested fields",
+			body:     `{"public_keys":[{"key_id":"k1","public_key":"pem"}],"config":{"public_token":"t"}}`,
+			expected: `{"config":{"public_token":"***"},"public_keys":[{"key_id":"k1","public_key":"***"}]}`,
+		},
+		{
+			name:     "null sensitive field",
+			body:     `{"secret":null}`,
+			expected: `{"secret":null}`,
+		},
+		{
+			name:     "not JSON",
+			body:     `secret=s`,
+			expected: `<non-JSON body omitted>`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := transport.MaskBody([]byte(tc.body)); got != tc.expected {
+				t.Errorf("MaskBody(%s) = %s, want %s", tc.body, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestLoggingTransport(t *testing.T) {
+	const responseBody = `{"request_id":"request-id-test-1234","secret":{"secret":"super-secret-value"}}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, responseBody)
+	}))
+	defer server.Close()
+
+	var logs bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &logs)
+
+	client := &http.Client{Transport: transport.NewLoggingTransport(nil)}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/v1/projects",
+		strings.NewReader(`{"password":"request-password"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(body) != responseBody {
+		t.Errorf("expected the response body to be passed on unchanged, got %s", body)
+	}
+
+	output := logs.String()
+	for _, expected := range []string{`"request_id":"request-id-test-1234"`, `"path":"/v1/projects"`, `"status_code":200`} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("expected the logs to contain %s, got:\n%s", expected, output)
+		}
+	}
+	for _, secret := range []string{"super-secret-value", "request-password"} {
+		if strings.Contains(output, secret) {
+			t.Errorf("expected %s to be masked in the logs, got:\n%s", secret, output)
+		}
+	}
+}
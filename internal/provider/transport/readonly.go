@@ -0,0 +1,40 @@
+package transport
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrReadOnly is returned by a ReadOnlyTransport for requests that could make changes.
+var ErrReadOnly = errors.New("the Stytch provider is in read-only mode")
+
+// ReadOnlyTransport is an http.RoundTripper that refuses every request that could make changes
+// through the Stytch API. Resources and actions check for read-only mode before making changes, so
+// this is a backstop that guarantees nothing is ever mutated, even by a code path that misses that
+// check.
+type ReadOnlyTransport struct {
+	base http.RoundTripper
+}
+
+// NewReadOnlyTransport wraps base so that only GET, HEAD and OPTIONS requests are sent. If base is
+// nil, http.DefaultTransport is used.
+func NewReadOnlyTransport(base http.RoundTripper) *ReadOnlyTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &ReadOnlyTransport{base: base}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *ReadOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return t.base.RoundTrip(req)
+	default:
+		if req.Body != nil {
+			_ = req.Body.Close()
+		}
+		return nil, fmt.Errorf("%w: refused %s %s", ErrReadOnly, req.Method, req.URL.Path)
+	}
+}
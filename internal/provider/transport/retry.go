@@ -0,0 +1,195 @@
+package transport
+
+import (
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	DefaultMaxRetries = 3
+	DefaultMinBackoff = 1 * time.Second
+	DefaultMaxBackoff = 30 * time.Second
+	// DefaultMaxRetryAfter is the longest delay a Retry-After header can ask for when the policy
+	// doesn't set one.
+	DefaultMaxRetryAfter = 2 * time.Minute
+)
+
+// RetryPolicy configures how failed requests to the Stytch API are retried.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of times a request is retried after the initial attempt.
+	MaxRetries int
+	// MinBackoff is the delay before the first retry. Each subsequent retry doubles the delay.
+	MinBackoff time.Duration
+	// MaxBackoff caps the delay between retries, unless the API asks for a longer one through a
+	// Retry-After header, which MaxRetryAfter caps instead.
+	MaxBackoff time.Duration
+	// MaxRetryAfter caps the delay a Retry-After header can ask for, so that the API can't stall an
+	// apply indefinitely. If zero, DefaultMaxRetryAfter is used.
+	MaxRetryAfter time.Duration
+}
+
+// DefaultRetryPolicy returns the retry policy used when the provider configuration doesn't
+// override it.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries:    DefaultMaxRetries,
+		MinBackoff:    DefaultMinBackoff,
+		MaxBackoff:    DefaultMaxBackoff,
+		MaxRetryAfter: DefaultMaxRetryAfter,
+	}
+}
+
+// RetryTransport is an http.RoundTripper that retries requests which failed with a transient error.
+//
+// Rate-limited (429) responses are retried for every method, since the API rejected them before
+// doing any work. Network errors and 5xx responses are only retried for idempotent methods, since
+// a non-idempotent request such as a POST may already have been applied.
+type RetryTransport struct {
+	base   http.RoundTripper
+	policy RetryPolicy
+}
+
+// NewRetryTransport wraps base with the given retry policy. If base is nil, http.DefaultTransport
+// is used.
+func NewRetryTransport(base http.RoundTripper, policy RetryPolicy) *RetryTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &RetryTransport{base: base, policy: policy}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.Body != nil && req.Body != http.NoBody {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(ctx)
+			req.Body = body
+		}
+
+		resp, err := t.base.RoundTrip(req)
+		if attempt >= t.policy.MaxRetries || !t.shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		backoff := t.backoff(req, attempt, resp)
+		fields := map[string]interface{}{
+			"method":  req.Method,
+			"path":    req.URL.Path,
+			"attempt": attempt + 1,
+			"backoff": backoff.String(),
+		}
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["status_code"] = resp.StatusCode
+			// Drain the body so that the connection can be reused.
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+		tflog.Warn(ctx, "Retrying Stytch API request", fields)
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// shouldRetry reports whether a request that produced resp or err is safe and worthwhile to retry.
+func (t *RetryTransport) shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	// A request whose body can't be replayed can't be retried.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	if err != nil {
+		if errors.Is(err, req.Context().Err()) && req.Context().Err() != nil {
+			return false
+		}
+		return isIdempotent(req.Method)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return true
+	case resp.StatusCode >= 500 && resp.StatusCode != http.StatusNotImplemented:
+		return isIdempotent(req.Method)
+	default:
+		return false
+	}
+}
+
+// backoff returns how long to wait before retrying req after the given attempt. A Retry-After
+// header on the response takes precedence over the exponential backoff, up to MaxRetryAfter.
+func (t *RetryTransport) backoff(req *http.Request, attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			maxRetryAfter := t.policy.MaxRetryAfter
+			if maxRetryAfter <= 0 {
+				maxRetryAfter = DefaultMaxRetryAfter
+			}
+			if retryAfter > maxRetryAfter {
+				tflog.Warn(req.Context(), "Capping the Retry-After delay requested by the Stytch API", map[string]interface{}{
+					"method":          req.Method,
+					"path":            req.URL.Path,
+					"retry_after":     retryAfter.String(),
+					"max_retry_after": maxRetryAfter.String(),
+				})
+				retryAfter = maxRetryAfter
+			}
+			return retryAfter
+		}
+	}
+
+	backoff := t.policy.MaxBackoff
+	if attempt < 32 {
+		backoff = min(t.policy.MinBackoff<<attempt, t.policy.MaxBackoff)
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	// Add up to 50% jitter so that concurrent requests don't retry in lockstep.
+	return backoff/2 + rand.N(backoff/2+1)
+}
+
+// parseRetryAfter parses a Retry-After header given either as a number of seconds or as an
+// HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
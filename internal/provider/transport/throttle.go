@@ -0,0 +1,93 @@
+package transport
+
+import (
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/time/rate"
+)
+
+// ThrottleTransport is an http.RoundTripper that limits the rate and concurrency of requests to the
+// Stytch API. A single ThrottleTransport is shared by every resource, so the limits apply to the
+// provider as a whole rather than to each resource.
+type ThrottleTransport struct {
+	base    http.RoundTripper
+	limiter *rate.Limiter
+	sem     chan struct{}
+}
+
+// NewThrottleTransport wraps base so that at most requestsPerSecond requests are started per second
+// and at most maxConcurrent requests are in flight at once. A limit of 0 disables that limit. If base
+// is nil, http.DefaultTransport is used.
+func NewThrottleTransport(base http.RoundTripper, requestsPerSecond int, maxConcurrent int) *ThrottleTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	t := &ThrottleTransport{base: base}
+	if requestsPerSecond > 0 {
+		t.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), requestsPerSecond)
+	}
+	if maxConcurrent > 0 {
+		t.sem = make(chan struct{}, maxConcurrent)
+	}
+	return t
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *ThrottleTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	if t.sem != nil {
+		select {
+		case t.sem <- struct{}{}:
+		default:
+			tflog.Debug(ctx, "Waiting for a free Stytch API request slot", map[string]interface{}{
+				"max_concurrent_requests": cap(t.sem),
+			})
+			select {
+			case t.sem <- struct{}{}:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+	}
+
+	if t.limiter != nil {
+		if err := t.limiter.Wait(ctx); err != nil {
+			t.release()
+			return nil, err
+		}
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		t.release()
+		return nil, err
+	}
+
+	// Hold the slot until the response body has been read and closed.
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: t.release}
+	return resp, nil
+}
+
+func (t *ThrottleTransport) release() {
+	if t.sem != nil {
+		<-t.sem
+	}
+}
+
+// releasingBody calls release once when the body is closed.
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+	once    sync.Once
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
@@ -0,0 +1,42 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// TimeoutTransport is an http.RoundTripper that bounds how long a single request to the Stytch API
+// may take, including reading the response body. When it wraps the transport used by a
+// RetryTransport, each attempt gets its own timeout and a timed out idempotent request is retried.
+type TimeoutTransport struct {
+	base    http.RoundTripper
+	timeout time.Duration
+}
+
+// NewTimeoutTransport wraps base so that each request is canceled after timeout. A timeout of 0
+// disables the timeout. If base is nil, http.DefaultTransport is used.
+func NewTimeoutTransport(base http.RoundTripper, timeout time.Duration) *TimeoutTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &TimeoutTransport{base: base, timeout: timeout}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *TimeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.timeout <= 0 {
+		return t.base.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	// Keep the deadline running until the response body has been read and closed.
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: cancel}
+	return resp, nil
+}
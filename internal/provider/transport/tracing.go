@@ -0,0 +1,55 @@
+package transport
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/tracing"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracingTransport is an http.RoundTripper that records a span for each request to the Stytch
+// API. Requests made while a resource operation's span is in their context become its children,
+// tagged with the same resource type and slugs.
+type TracingTransport struct {
+	base   http.RoundTripper
+	tracer trace.Tracer
+}
+
+// NewTracingTransport wraps base so that each request is traced with tracer. If base is nil,
+// http.DefaultTransport is used.
+func NewTracingTransport(base http.RoundTripper, tracer trace.Tracer) *TracingTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &TracingTransport{base: base, tracer: tracer}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *TracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, span := t.tracer.Start(req.Context(), "Stytch API "+req.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.HTTPRequestMethodKey.String(req.Method),
+			semconv.URLPath(req.URL.Path),
+			semconv.ServerAddress(req.URL.Hostname()),
+		),
+		trace.WithAttributes(tracing.ResourceAttributes(req.Context())...),
+	)
+	defer span.End()
+
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetAttributes(semconv.HTTPResponseStatusCode(resp.StatusCode))
+	if resp.StatusCode >= http.StatusInternalServerError {
+		span.SetStatus(codes.Error, fmt.Sprintf("HTTP %d", resp.StatusCode))
+	}
+	return resp, nil
+}
@@ -0,0 +1,86 @@
+package transport_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/tracing"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/transport"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// fakeData is a plan or state holding string attributes at the root.
+type fakeData map[string]types.String
+
+func (d fakeData) GetAttribute(_ context.Context, attrPath path.Path, target interface{}) diag.Diagnostics {
+	*target.(*types.String) = d[attrPath.String()]
+	return nil
+}
+
+func TestTracingTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	otel.SetTracerProvider(provider)
+	client := &http.Client{Transport: transport.NewTracingTransport(nil, provider.Tracer("test"))}
+
+	ctx, parent := tracing.StartResourceSpan(context.Background(), "stytch_redirect_url", "Create", fakeData{
+		"project_slug":     types.StringValue("my-project"),
+		"environment_slug": types.StringValue("production"),
+	})
+	for _, path := range []string{"/ok", "/fail"} {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		resp.Body.Close()
+	}
+	parent.End()
+
+	spans := recorder.Ended()
+	if len(spans) != 3 {
+		t.Fatalf("expected 3 spans, got %d", len(spans))
+	}
+	for _, span := range spans[:2] {
+		if span.Parent().SpanID() != parent.SpanContext().SpanID() {
+			t.Errorf("expected span %s to be a child of the resource operation's span", span.Name())
+		}
+		attrs := attribute.NewSet(span.Attributes()...)
+		for key, expected := range map[attribute.Key]string{
+			tracing.ResourceTypeKey:    "stytch_redirect_url",
+			tracing.ProjectSlugKey:     "my-project",
+			tracing.EnvironmentSlugKey: "production",
+		} {
+			if v, _ := attrs.Value(key); v.AsString() != expected {
+				t.Errorf("expected %s of span %s to be %q, got %q", key, span.Name(), expected, v.AsString())
+			}
+		}
+	}
+	if spans[0].Status().Code == codes.Error {
+		t.Errorf("expected the successful request's span not to be an error")
+	}
+	if spans[1].Status().Code != codes.Error {
+		t.Errorf("expected the failed request's span to be an error, got %v", spans[1].Status())
+	}
+}
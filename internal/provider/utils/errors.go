@@ -0,0 +1,22 @@
+package utils
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/stytcherror"
+)
+
+// IsNotFound reports whether err is a Stytch API error for something that doesn't exist, such as a
+// resource that was deleted outside of Terraform.
+func IsNotFound(err error) bool {
+	var stytchErr stytcherror.Error
+	if errors.As(err, &stytchErr) {
+		return stytchErr.StatusCode == http.StatusNotFound
+	}
+	var stytchErrPtr *stytcherror.Error
+	if errors.As(err, &stytchErrPtr) && stytchErrPtr != nil {
+		return stytchErrPtr.StatusCode == http.StatusNotFound
+	}
+	return false
+}
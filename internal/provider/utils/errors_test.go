@@ -0,0 +1,31 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/stytcherror"
+)
+
+func TestIsNotFound(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "nil", err: nil},
+		{name: "other error", err: errors.New("error sending http request")},
+		{name: "not found", err: stytcherror.Error{StatusCode: 404, ErrorMessage: "Not found."}, expected: true},
+		{name: "not found pointer", err: &stytcherror.Error{StatusCode: 404}, expected: true},
+		{name: "wrapped not found", err: fmt.Errorf("get secret: %w", stytcherror.Error{StatusCode: 404}), expected: true},
+		{name: "bad request", err: stytcherror.Error{StatusCode: 400}},
+		{name: "server error", err: stytcherror.Error{StatusCode: 500}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := IsNotFound(tc.err); got != tc.expected {
+				t.Errorf("IsNotFound(%v) = %v, want %v", tc.err, got, tc.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Separators of the parts of resource IDs. IDs are normally written as dot-separated parts, such
+// as "my-project.production.LOGIN". Parts that would make that ambiguous, such as a URL that
+// contains "|", are written as a JSON array of strings instead. Import IDs may also separate their
+// parts with "|", which is easier to type than a JSON array when a part contains dots.
+const (
+	idSeparator       = "."
+	idImportSeparator = "|"
+	idJSONArrayPrefix = "["
+)
+
+// FormatID returns the ID of a resource made of the given parts, which ParseID splits back into
+// the same parts. Only the last part may contain dots for the ID to be dot-separated, which is the
+// case of every ID whose leading parts are slugs.
+func FormatID(parts ...string) string {
+	if !needsJSONID(parts) {
+		return strings.Join(parts, idSeparator)
+	}
+	// Marshalling a slice of strings can't fail.
+	id, _ := json.Marshal(parts)
+	return string(id)
+}
+
+func needsJSONID(parts []string) bool {
+	if len(parts) == 0 || strings.HasPrefix(parts[0], idJSONArrayPrefix) {
+		return true
+	}
+	for i, part := range parts {
+		if strings.Contains(part, idImportSeparator) {
+			return true
+		}
+		if i < len(parts)-1 && strings.Contains(part, idSeparator) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseID splits a resource or import ID into one part for each of names, which name the parts in
+// the error returned for a malformed ID. It accepts:
+//
+//   - a JSON array of strings, such as `["my-project","production","https://example.com"]`;
+//   - "|"-separated parts, such as "my-project|production|https://example.com";
+//   - dot-separated parts, such as "my-project.production.https://example.com", where everything
+//     after the separator that precedes the last part belongs to the last part.
+func ParseID(id string, names ...string) ([]string, error) {
+	var parts []string
+	switch {
+	case strings.HasPrefix(id, idJSONArrayPrefix):
+		if err := json.Unmarshal([]byte(id), &parts); err != nil {
+			return nil, idFormatError(id, names, err.Error())
+		}
+	case strings.Contains(id, idImportSeparator):
+		parts = strings.SplitN(id, idImportSeparator, len(names))
+	default:
+		parts = strings.SplitN(id, idSeparator, len(names))
+	}
+	if len(parts) != len(names) {
+		return nil, idFormatError(id, names, fmt.Sprintf("expected %d parts, got %d", len(names), len(parts)))
+	}
+	return parts, nil
+}
+
+func idFormatError(id string, names []string, reason string) error {
+	return fmt.Errorf("expected import ID format: %s (or %s, or a JSON array of strings), got: %s (%s)",
+		strings.Join(names, idSeparator), strings.Join(names, idImportSeparator), id, reason)
+}
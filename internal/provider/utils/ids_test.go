@@ -0,0 +1,127 @@
+package utils
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestFormatID(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		parts    []string
+		expected string
+	}{
+		{name: "slugs", parts: []string{"my-project", "production"}, expected: "my-project.production"},
+		{name: "url", parts: []string{"my-project", "production", "https://app.example.com/callback"},
+			expected: "my-project.production.https://app.example.com/callback"},
+		{name: "dot in leading part", parts: []string{"my.project", "production"}, expected: `["my.project","production"]`},
+		{name: "pipe", parts: []string{"my-project", "production", "https://example.com/?a=1|2"},
+			expected: `["my-project","production","https://example.com/?a=1|2"]`},
+		{name: "leading bracket", parts: []string{"[project", "production"}, expected: `["[project","production"]`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := FormatID(tc.parts...); got != tc.expected {
+				t.Errorf("FormatID(%q) = %q, want %q", tc.parts, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestParseID(t *testing.T) {
+	names := []string{"project_slug", "environment_slug", "url"}
+	expected := []string{"my-project", "production", "https://app.example.com/callback"}
+	for _, tc := range []struct {
+		name string
+		id   string
+	}{
+		{name: "dot-separated", id: "my-project.production.https://app.example.com/callback"},
+		{name: "pipe-separated", id: "my-project|production|https://app.example.com/callback"},
+		{name: "JSON", id: `["my-project","production","https://app.example.com/callback"]`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			parts, err := ParseID(tc.id, names...)
+			if err != nil {
+				t.Fatalf("ParseID(%q) returned error: %v", tc.id, err)
+			}
+			if !reflect.DeepEqual(parts, expected) {
+				t.Errorf("ParseID(%q) = %q, want %q", tc.id, parts, expected)
+			}
+		})
+	}
+}
+
+func TestParseIDErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		id   string
+	}{
+		{name: "too few parts", id: "my-project"},
+		{name: "JSON object", id: `{"project_slug":"my-project"}`},
+		{name: "too many JSON parts", id: `["my-project","production","LOGIN"]`},
+		{name: "invalid JSON", id: `["my-project",`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseID(tc.id, "project_slug", "environment_slug")
+			if err == nil {
+				t.Fatalf("ParseID(%q) didn't return an error", tc.id)
+			}
+			if !strings.Contains(err.Error(), "project_slug.environment_slug") {
+				t.Errorf("error %q doesn't describe the expected format", err)
+			}
+		})
+	}
+}
+
+func FuzzIDRoundTrip2(f *testing.F) {
+	f.Add("my-project", "production")
+	f.Add("my.project", "production")
+	f.Add("[", "|")
+	f.Fuzz(func(t *testing.T, a, b string) {
+		testRoundTrip(t, a, b)
+	})
+}
+
+func FuzzIDRoundTrip3(f *testing.F) {
+	f.Add("my-project", "production", "https://app.example.com/callback")
+	f.Add("my-project", "production", "https://example.com/?a=1|2")
+	f.Add("", "", "")
+	f.Add(`["`, ".", `"]`)
+	f.Fuzz(func(t *testing.T, a, b, c string) {
+		testRoundTrip(t, a, b, c)
+	})
+}
+
+func testRoundTrip(t *testing.T, parts ...string) {
+	// Terraform strings, and so IDs, are always valid UTF-8.
+	for _, part := range parts {
+		if !utf8.ValidString(part) {
+			t.Skip()
+		}
+	}
+	names := make([]string, len(parts))
+	id := FormatID(parts...)
+	parsed, err := ParseID(id, names...)
+	if err != nil {
+		t.Fatalf("ParseID(FormatID(%q)) returned error: %v", parts, err)
+	}
+	if !reflect.DeepEqual(parsed, parts) {
+		t.Fatalf("ParseID(FormatID(%q)) = %q", parts, parsed)
+	}
+}
+
+func FuzzParseID(f *testing.F) {
+	f.Add("my-project.production.https://app.example.com/callback")
+	f.Add("my-project|production|LOGIN")
+	f.Add(`["my-project","production","LOGIN"]`)
+	f.Add("[")
+	f.Fuzz(func(t *testing.T, id string) {
+		parts, err := ParseID(id, "project_slug", "environment_slug", "url")
+		if err != nil {
+			return
+		}
+		// Whatever ParseID accepts, FormatID writes in a form that parses to the same parts.
+		testRoundTrip(t, parts...)
+	})
+}
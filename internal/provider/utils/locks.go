@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Endpoint families that are written to by more than one resource.
+const (
+	LockFamilyRedirectURLs         = "redirect_urls"
+	LockFamilyCountryCodeAllowlist = "country_code_allowlist"
+	LockFamilyEmailTemplates       = "email_templates"
+)
+
+// WriteLocks serializes writes to endpoints that are shared between several resources, such as the
+// redirect URLs of an environment, so that resources applied in parallel don't race with each other
+// and the last writer doesn't silently win.
+var WriteLocks = NewMutexKV()
+
+// EnvironmentLockKey returns the WriteLocks key for an endpoint family scoped to an environment.
+func EnvironmentLockKey(family, projectSlug, environmentSlug string) string {
+	return fmt.Sprintf("%s/%s.%s", family, projectSlug, environmentSlug)
+}
+
+// ProjectLockKey returns the WriteLocks key for an endpoint family scoped to a project.
+func ProjectLockKey(family, projectSlug string) string {
+	return fmt.Sprintf("%s/%s", family, projectSlug)
+}
+
+// MutexKV is a set of mutexes identified by string keys.
+type MutexKV struct {
+	mu    sync.Mutex
+	store map[string]*sync.Mutex
+}
+
+func NewMutexKV() *MutexKV {
+	return &MutexKV{store: make(map[string]*sync.Mutex)}
+}
+
+// Lock locks the mutex for the given key, creating it if needed, and returns a function that
+// unlocks it.
+func (m *MutexKV) Lock(key string) func() {
+	m.mu.Lock()
+	mutex, ok := m.store[key]
+	if !ok {
+		mutex = &sync.Mutex{}
+		m.store[key] = mutex
+	}
+	m.mu.Unlock()
+
+	mutex.Lock()
+	return mutex.Unlock
+}
@@ -0,0 +1,61 @@
+package utils
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestMutexKVSerializesSameKey(t *testing.T) {
+	m := NewMutexKV()
+	unlock := m.Lock("a")
+
+	acquired := make(chan struct{})
+	go func() {
+		defer m.Lock("a")()
+		close(acquired)
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatal("expected second Lock on the same key to block")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	unlock()
+	select {
+	case <-acquired:
+	case <-time.After(time.Second):
+		t.Fatal("expected second Lock to succeed after Unlock")
+	}
+}
+
+func TestMutexKVIndependentKeys(t *testing.T) {
+	m := NewMutexKV()
+	unlock := m.Lock(EnvironmentLockKey(LockFamilyRedirectURLs, "project", "test"))
+	defer unlock()
+
+	var wg sync.WaitGroup
+	for _, key := range []string{
+		EnvironmentLockKey(LockFamilyRedirectURLs, "project", "live"),
+		EnvironmentLockKey(LockFamilyCountryCodeAllowlist, "project", "test"),
+		ProjectLockKey(LockFamilyEmailTemplates, "project"),
+	} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			m.Lock(key)()
+		}()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected locks on different keys not to block each other")
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/stytchauth/terraform-provider-stytch/internal/provider"
+	"github.com/stytchauth/terraform-provider-stytch/internal/provider/tracing"
 )
 
 // goreleaser can pass other information to the main package, such as the specific commit
@@ -25,7 +26,17 @@ func main() {
 		Debug:   debug,
 	}
 
-	err := providerserver.Serve(context.Background(), provider.New(provider.Version), opts)
+	ctx := context.Background()
+	shutdownTracing, err := tracing.Setup(ctx, provider.Version)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	err = providerserver.Serve(ctx, provider.New(provider.Version), opts)
+	// Flush the spans that haven't been exported yet, even if serving failed.
+	if shutdownErr := shutdownTracing(ctx); shutdownErr != nil {
+		log.Printf("failed to flush traces: %s", shutdownErr.Error())
+	}
 	if err != nil {
 		log.Fatal(err.Error())
 	}